@@ -7,17 +7,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/apikey"
 	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
 	"github.com/xh-polaris/innospark-core-api/pkg/logs"
 	"go.opentelemetry.io/otel/propagation"
 )
 
-const HertzContext = "hertz_context"
+const (
+	HertzContext   = "hertz_context"
+	CtxAPIKey      = "api_key"
+	CtxAllowAPIKey = "allow_api_key"
+)
 
 func InjectContext(ctx context.Context, c *app.RequestContext) context.Context {
 	return context.WithValue(ctx, HertzContext, c)
@@ -42,8 +48,49 @@ func ExtractUserId(ctx context.Context) (userId string, err error) {
 	if err != nil {
 		return
 	}
-	tokenString := c.GetHeader("Authorization")
-	return ExtractUserIdFromJWT(string(tokenString))
+	tokenString := strings.TrimPrefix(string(c.GetHeader("Authorization")), "Bearer ") // 兼容OpenAI SDK的Bearer格式
+	if apikey.IsKey(tokenString) {
+		if !c.GetBool(CtxAllowAPIKey) { // 只有允许的接口接受API密钥, 其余接口需要登录
+			err = errors.New("api key is not allowed on this endpoint")
+			return
+		}
+		return ExtractUserIdFromAPIKey(ctx, c, tokenString)
+	}
+	return ExtractUserIdFromJWT(tokenString)
+}
+
+// AllowAPIKey 路由中间件, 允许该路由通过API密钥鉴权, 只用于对话与模型列表接口
+func AllowAPIKey(ctx context.Context, c *app.RequestContext) {
+	c.Set(CtxAllowAPIKey, true)
+	c.Next(ctx)
+}
+
+// ExtractUserIdFromAPIKey 通过API密钥鉴权, 通过后将密钥保存在请求上下文中, 供业务校验模型范围与配额
+func ExtractUserIdFromAPIKey(ctx context.Context, c *app.RequestContext, key string) (userId string, err error) {
+	if k, ok := c.Get(CtxAPIKey); ok { // 同一请求中已校验过
+		return k.(*apikey.APIKey).UserId.Hex(), nil
+	}
+	k, err := apikey.Mapper.GetByHash(ctx, apikey.HashKey(key))
+	if err != nil {
+		return "", errors.New("api key is not valid")
+	}
+	if err = apikey.Mapper.Touch(ctx, k); err != nil {
+		logs.CtxErrorf(ctx, "touch api key err: %s", errorx.ErrorWithoutStack(err))
+	}
+	c.Set(CtxAPIKey, k)
+	return k.UserId.Hex(), nil
+}
+
+// ExtractAPIKey 本次请求使用的API密钥, 通过JWT鉴权时为nil
+func ExtractAPIKey(ctx context.Context) *apikey.APIKey {
+	c, err := ExtractContext(ctx)
+	if err != nil {
+		return nil
+	}
+	if k, ok := c.Get(CtxAPIKey); ok {
+		return k.(*apikey.APIKey)
+	}
+	return nil
 }
 
 func ExtractUserIdFromJWT(tokenString string) (userId string, err error) {
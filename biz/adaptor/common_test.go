@@ -0,0 +1,37 @@
+package adaptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/apikey"
+)
+
+func TestExtractUserIdAPIKey(t *testing.T) {
+	cases := []struct {
+		name   string
+		auth   string
+		allow  bool
+		userId string
+		err    bool
+	}{
+		{"jwt", "Bearer xh-polaris", false, "67aac4d14e8825731a1503d8", false},
+		{"jwt on api key route", "xh-polaris", true, "67aac4d14e8825731a1503d8", false},
+		{"api key on jwt route", "Bearer " + apikey.KeyPrefix + "abc", false, "", true},
+		{"raw api key on jwt route", apikey.KeyPrefix + "abc", false, "", true},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		rc := app.NewContext(0)
+		rc.Request.Header.Set("Authorization", c.auth)
+		if c.allow {
+			AllowAPIKey(context.Background(), rc)
+		}
+		uid, err := ExtractUserId(InjectContext(context.Background(), rc))
+		g.Expect(err != nil).To(Equal(c.err), c.name)
+		g.Expect(uid).To(Equal(c.userId), c.name)
+		g.Expect(ExtractAPIKey(InjectContext(context.Background(), rc))).To(BeNil(), c.name)
+	}
+}
@@ -9,10 +9,19 @@ import (
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
 	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
+	"github.com/xh-polaris/innospark-core-api/biz/application/service/apikey"
 	"github.com/xh-polaris/innospark-core-api/biz/application/service/completions"
 	"github.com/xh-polaris/innospark-core-api/biz/application/service/conversation"
+	"github.com/xh-polaris/innospark-core-api/biz/application/service/export"
 	"github.com/xh-polaris/innospark-core-api/biz/application/service/feedback"
+	"github.com/xh-polaris/innospark-core-api/biz/application/service/gateway"
+	"github.com/xh-polaris/innospark-core-api/biz/application/service/memory"
+	"github.com/xh-polaris/innospark-core-api/biz/application/service/share"
+	"github.com/xh-polaris/innospark-core-api/biz/application/service/trash"
 	"github.com/xh-polaris/innospark-core-api/biz/application/service/user"
+	dgateway "github.com/xh-polaris/innospark-core-api/biz/domain/gateway"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
 )
 
 // Completions .
@@ -330,3 +339,492 @@ func CheckVerifyCode(ctx context.Context, c *app.RequestContext) {
 	resp, err := user.UserSVC.CheckVerifyCode(ctx, &req)
 	adaptor.PostProcess(ctx, c, &req, resp, err)
 }
+
+// ListMemory .
+// @router /memory/list [POST]
+func ListMemory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ListMemoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := memory.MemorySVC.ListMemory(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// DeleteMemory .
+// @router /memory/delete [POST]
+func DeleteMemory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.DeleteMemoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := memory.MemorySVC.DeleteMemory(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ClearMemory .
+// @router /memory/clear [POST]
+func ClearMemory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ClearMemoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := memory.MemorySVC.ClearMemory(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// NewSection .
+// @router /conversation/new_section [POST]
+func NewSection(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.NewSectionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.NewSection(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListBranch .
+// @router /conversation/list_branch [POST]
+func ListBranch(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ListBranchReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.ListBranch(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// SwitchBranch .
+// @router /conversation/switch_branch [POST]
+func SwitchBranch(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.SwitchBranchReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.SwitchBranch(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ForkConversation .
+// @router /conversation/fork [POST]
+func ForkConversation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ForkConversationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.ForkConversation(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ExportConversation .
+// @router /conversation/export [POST]
+func ExportConversation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ExportConversationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = export.ExportSVC.ExportConversation(ctx, c, &req)
+	adaptor.PostStream(ctx, c, &req, err)
+}
+
+// ExportAll .
+// @router /conversation/export_all [POST]
+func ExportAll(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ExportAllReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := export.ExportSVC.ExportAll(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetExportStatus .
+// @router /conversation/export_status [POST]
+func GetExportStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.GetExportStatusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := export.ExportSVC.GetExportStatus(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ImportConversation .
+// @router /conversation/import [POST]
+func ImportConversation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ImportConversationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := export.ExportSVC.ImportConversation(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CreateShare .
+// @router /share/create [POST]
+func CreateShare(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.CreateShareReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := share.ShareSVC.CreateShare(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListShare .
+// @router /share/list [POST]
+func ListShare(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ListShareReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := share.ShareSVC.ListShare(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RevokeShare .
+// @router /share/revoke [POST]
+func RevokeShare(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.RevokeShareReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := share.ShareSVC.RevokeShare(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ViewShare .
+// @router /share/view [POST]
+func ViewShare(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ViewShareReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := share.ShareSVC.ViewShare(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ContinueShare .
+// @router /share/continue [POST]
+func ContinueShare(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ContinueShareReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := share.ShareSVC.ContinueShare(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// SearchMessage .
+// @router /conversation/search_message [POST]
+func SearchMessage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.SearchMessageReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.SearchMessage(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// PinConversation .
+// @router /conversation/pin [POST]
+func PinConversation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.PinConversationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.PinConversation(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// MoveConversation .
+// @router /conversation/move [POST]
+func MoveConversation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.MoveConversationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.MoveConversation(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// TagConversation .
+// @router /conversation/tag [POST]
+func TagConversation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.TagConversationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.TagConversation(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListTag .
+// @router /conversation/list_tag [POST]
+func ListTag(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ListTagReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.ListTag(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CreateFolder .
+// @router /folder/create [POST]
+func CreateFolder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.CreateFolderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.CreateFolder(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListFolder .
+// @router /folder/list [POST]
+func ListFolder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ListFolderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.ListFolder(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RenameFolder .
+// @router /folder/rename [POST]
+func RenameFolder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.RenameFolderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.RenameFolder(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// DeleteFolder .
+// @router /folder/delete [POST]
+func DeleteFolder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.DeleteFolderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := conversation.ConversationSVC.DeleteFolder(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListTrash .
+// @router /conversation/list_trash [POST]
+func ListTrash(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ListTrashReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := trash.TrashSVC.ListTrash(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RestoreConversation .
+// @router /conversation/restore [POST]
+func RestoreConversation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.RestoreConversationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := trash.TrashSVC.RestoreConversation(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ChatCompletions OpenAI兼容的对话补全
+// @router /v1/chat/completions [POST]
+func ChatCompletions(ctx context.Context, c *app.RequestContext) {
+	var req dgateway.ChatCompletionReq
+	if err := c.BindJSON(&req); err != nil {
+		adaptor.PostOpenAI(ctx, c, &req, nil, errorx.New(errno.GatewayInvalidErrCode, errorx.KV("reason", err.Error())))
+		return
+	}
+
+	resp, err := gateway.GatewaySVC.ChatCompletions(ctx, c, &req)
+	if resp == nil && err == nil { // 流式响应已写入
+		adaptor.PostOpenAI(ctx, c, &req, nil, nil)
+		return
+	}
+	adaptor.PostOpenAI(ctx, c, &req, resp, err)
+}
+
+// ListModels OpenAI兼容的模型列表
+// @router /v1/models [GET]
+func ListModels(ctx context.Context, c *app.RequestContext) {
+	resp, err := gateway.GatewaySVC.ListModels(ctx)
+	adaptor.PostOpenAI(ctx, c, nil, resp, err)
+}
+
+// CreateAPIKey .
+// @router /apikey/create [POST]
+func CreateAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.CreateAPIKeyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := apikey.APIKeySVC.CreateAPIKey(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListAPIKey .
+// @router /apikey/list [POST]
+func ListAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.ListAPIKeyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := apikey.APIKeySVC.ListAPIKey(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// UpdateAPIKey .
+// @router /apikey/update [POST]
+func UpdateAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.UpdateAPIKeyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := apikey.APIKeySVC.UpdateAPIKey(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RevokeAPIKey .
+// @router /apikey/revoke [POST]
+func RevokeAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req core_api.RevokeAPIKeyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := apikey.APIKeySVC.RevokeAPIKey(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
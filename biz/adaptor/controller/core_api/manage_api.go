@@ -86,3 +86,78 @@ func UserStatistic(ctx context.Context, c *app.RequestContext) {
 	resp, err := manageapp.ManageSVC.UserStatistics(ctx, &req)
 	adaptor.PostProcess(ctx, c, &req, resp, err)
 }
+
+// UsageStatistic .
+// @router /admin/statistic/usage [POST]
+func UsageStatistic(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req manage.UsageStatisticsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := manageapp.ManageSVC.UsageStatistics(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// TopUsageUser .
+// @router /admin/statistic/usage/top_user [POST]
+func TopUsageUser(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req manage.TopUsageUserReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := manageapp.ManageSVC.TopUsageUser(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ActiveStatistic .
+// @router /admin/statistic/active [POST]
+func ActiveStatistic(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req manage.ActiveStatisticsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := manageapp.ManageSVC.ActiveStatistic(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// MessageStatistic .
+// @router /admin/statistic/message [POST]
+func MessageStatistic(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req manage.MessageStatisticsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := manageapp.ManageSVC.MessageStatistic(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RetentionStatistic .
+// @router /admin/statistic/retention [POST]
+func RetentionStatistic(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req manage.RetentionStatisticsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := manageapp.ManageSVC.RetentionStatistic(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
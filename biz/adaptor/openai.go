@@ -0,0 +1,61 @@
+package adaptor
+
+// OpenAI兼容接口的响应
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/xh-polaris/gopkg/util"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/gateway"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PostOpenAI 按OpenAI的格式处理响应, 成功时直接返回resp, 流式响应时resp为nil且响应体已由业务写入
+// 错误使用对应的http状态码与{"error": {...}}结构, 以便OpenAI SDK识别
+func PostOpenAI(ctx context.Context, c *app.RequestContext, req, resp any, err error) {
+	b3.New().Inject(ctx, &headerProvider{headers: &c.Response.Header})
+	logs.CtxInfof(ctx, "[%s] req=%s, err=%s, trace=%s", c.Path(), util.JSONF(req), errorx.ErrorWithoutStack(err), trace.SpanContextFromContext(ctx).TraceID().String())
+
+	if err == nil {
+		if resp != nil {
+			c.JSON(http.StatusOK, resp)
+		}
+		return
+	}
+	status, e := OpenAIError(err)
+	if status == http.StatusInternalServerError {
+		logs.CtxErrorf(ctx, "internal error, err=%s", errorx.ErrorWithoutStack(err))
+	}
+	c.AbortWithStatusJSON(status, &gateway.ErrorResp{Error: e})
+}
+
+// OpenAIError 将错误转换为OpenAI格式的错误与http状态码
+func OpenAIError(err error) (int, *gateway.Error) {
+	var customErr errorx.StatusError
+	if !errors.As(err, &customErr) || customErr.Code() == 0 {
+		return http.StatusInternalServerError, &gateway.Error{Message: err.Error(), Type: "server_error"}
+	}
+	e := &gateway.Error{Message: customErr.Msg(), Type: "invalid_request_error", Code: customErr.Code()}
+	switch customErr.Code() {
+	case errno.UnAuthErrCode:
+		return http.StatusUnauthorized, &gateway.Error{Message: e.Message, Type: "authentication_error", Code: e.Code}
+	case errno.ErrForbidden, errno.APIKeyModelDeniedErrCode:
+		return http.StatusForbidden, &gateway.Error{Message: e.Message, Type: "permission_error", Code: e.Code}
+	case errno.APIKeyQuotaExceededErrCode, errno.DailyQuotaExceededErrCode, errno.MonthlyQuotaExceededErrCode:
+		return http.StatusTooManyRequests, &gateway.Error{Message: e.Message, Type: "insufficient_quota", Code: e.Code}
+	case errno.RateLimitedErrCode:
+		return http.StatusTooManyRequests, &gateway.Error{Message: e.Message, Type: "rate_limit_error", Code: e.Code}
+	case errno.GatewayModelNotFoundErrCode:
+		return http.StatusNotFound, e
+	case errno.GatewayErrCode:
+		return http.StatusBadGateway, &gateway.Error{Message: e.Message, Type: "server_error", Code: e.Code}
+	}
+	return http.StatusBadRequest, e
+}
@@ -39,6 +39,16 @@ func PostProcess(ctx context.Context, c *app.RequestContext, req, resp any, err
 	PostError(ctx, c, err)
 }
 
+// PostStream 处理流式响应, 响应体已由业务写入, 只在出错时响应错误
+func PostStream(ctx context.Context, c *app.RequestContext, req any, err error) {
+	b3.New().Inject(ctx, &headerProvider{headers: &c.Response.Header})
+	logs.CtxInfof(ctx, "[%s] req=%s, resp=stream, err=%s, trace=%s", c.Path(), util.JSONF(req), errorx.ErrorWithoutStack(err), trace.SpanContextFromContext(ctx).TraceID().String())
+
+	if err != nil {
+		PostError(ctx, c, err)
+	}
+}
+
 // PostError 处理错误
 func PostError(ctx context.Context, c *app.RequestContext, err error) {
 	var customErr errorx.StatusError
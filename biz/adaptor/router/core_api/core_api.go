@@ -31,6 +31,12 @@ func Register(r *server.Hertz) {
 		}
 		{
 			_statistic := _admin.Group("/statistic", _statisticMw()...)
+			_statistic.POST("/active", append(_activestatisticMw(), core_api.ActiveStatistic)...)
+			_statistic.POST("/message", append(_messagestatisticMw(), core_api.MessageStatistic)...)
+			_statistic.POST("/retention", append(_retentionstatisticMw(), core_api.RetentionStatistic)...)
+			_statistic.POST("/usage", append(_usagestatisticMw(), core_api.UsageStatistic)...)
+			_usage := _statistic.Group("/usage", _usageMw()...)
+			_usage.POST("/top_user", append(_topusageuserMw(), core_api.TopUsageUser)...)
 			_statistic.POST("/user", append(_userstatisticMw(), core_api.UserStatistic)...)
 		}
 	}
@@ -38,6 +44,13 @@ func Register(r *server.Hertz) {
 		_agents := root.Group("/agents", _agentsMw()...)
 		_agents.POST("/list", append(_listagentsMw(), core_api.ListAgents)...)
 	}
+	{
+		_apikey := root.Group("/apikey", _apikeyMw()...)
+		_apikey.POST("/create", append(_createapikeyMw(), core_api.CreateAPIKey)...)
+		_apikey.POST("/list", append(_listapikeyMw(), core_api.ListAPIKey)...)
+		_apikey.POST("/revoke", append(_revokeapikeyMw(), core_api.RevokeAPIKey)...)
+		_apikey.POST("/update", append(_updateapikeyMw(), core_api.UpdateAPIKey)...)
+	}
 	{
 		_basic_user := root.Group("/basic_user", _basic_userMw()...)
 		_basic_user.POST("/login", append(_basicuserloginMw(), core_api.BasicUserLogin)...)
@@ -51,18 +64,54 @@ func Register(r *server.Hertz) {
 		_conversation.POST("/brief", append(_generateMw(), core_api.Generate)...)
 		_conversation.POST("/create", append(_createconversationMw(), core_api.CreateConversation)...)
 		_conversation.POST("/delete", append(_deleteconversationMw(), core_api.DeleteConversation)...)
+		_conversation.POST("/export", append(_exportconversationMw(), core_api.ExportConversation)...)
+		_conversation.POST("/export_all", append(_exportallMw(), core_api.ExportAll)...)
+		_conversation.POST("/export_status", append(_getexportstatusMw(), core_api.GetExportStatus)...)
+		_conversation.POST("/fork", append(_forkconversationMw(), core_api.ForkConversation)...)
 		_conversation.POST("/get", append(_getconversationMw(), core_api.GetConversation)...)
 		_conversation.POST("/get_ext", append(_getconversationextMw(), core_api.GetConversationExt)...)
+		_conversation.POST("/import", append(_importconversationMw(), core_api.ImportConversation)...)
 		_conversation.POST("/list", append(_listconversationMw(), core_api.ListConversation)...)
+		_conversation.POST("/list_branch", append(_listbranchMw(), core_api.ListBranch)...)
+		_conversation.POST("/list_tag", append(_listtagMw(), core_api.ListTag)...)
+		_conversation.POST("/list_trash", append(_listtrashMw(), core_api.ListTrash)...)
+		_conversation.POST("/move", append(_moveconversationMw(), core_api.MoveConversation)...)
+		_conversation.POST("/new_section", append(_newsectionMw(), core_api.NewSection)...)
+		_conversation.POST("/pin", append(_pinconversationMw(), core_api.PinConversation)...)
 		_conversation.POST("/rename", append(_renameconversationMw(), core_api.RenameConversation)...)
+		_conversation.POST("/restore", append(_restoreconversationMw(), core_api.RestoreConversation)...)
 		_conversation.POST("/search", append(_searchconversationMw(), core_api.SearchConversation)...)
+		_conversation.POST("/search_message", append(_searchmessageMw(), core_api.SearchMessage)...)
+		_conversation.POST("/switch_branch", append(_switchbranchMw(), core_api.SwitchBranch)...)
+		_conversation.POST("/tag", append(_tagconversationMw(), core_api.TagConversation)...)
 		_conversation.POST("/update_ext", append(_updateconversationextMw(), core_api.UpdateConversationExt)...)
 	}
+	{
+		_folder := root.Group("/folder", _folderMw()...)
+		_folder.POST("/create", append(_createfolderMw(), core_api.CreateFolder)...)
+		_folder.POST("/delete", append(_deletefolderMw(), core_api.DeleteFolder)...)
+		_folder.POST("/list", append(_listfolderMw(), core_api.ListFolder)...)
+		_folder.POST("/rename", append(_renamefolderMw(), core_api.RenameFolder)...)
+	}
 	{
 		_intelligence := root.Group("/intelligence", _intelligenceMw()...)
 		_intelligence.POST("/get", append(_getintelligenceMw(), core_api.GetIntelligence)...)
 		_intelligence.POST("/list", append(_listintelligenceMw(), core_api.ListIntelligence)...)
 	}
+	{
+		_memory := root.Group("/memory", _memoryMw()...)
+		_memory.POST("/clear", append(_clearmemoryMw(), core_api.ClearMemory)...)
+		_memory.POST("/delete", append(_deletememoryMw(), core_api.DeleteMemory)...)
+		_memory.POST("/list", append(_listmemoryMw(), core_api.ListMemory)...)
+	}
+	{
+		_share := root.Group("/share", _shareMw()...)
+		_share.POST("/continue", append(_continueshareMw(), core_api.ContinueShare)...)
+		_share.POST("/create", append(_createshareMw(), core_api.CreateShare)...)
+		_share.POST("/list", append(_listshareMw(), core_api.ListShare)...)
+		_share.POST("/revoke", append(_revokeshareMw(), core_api.RevokeShare)...)
+		_share.POST("/view", append(_viewshareMw(), core_api.ViewShare)...)
+	}
 	{
 		_system := root.Group("/system", _systemMw()...)
 		_system.POST("/check_verify_code", append(_checkverifycodeMw(), core_api.CheckVerifyCode)...)
@@ -75,6 +124,8 @@ func Register(r *server.Hertz) {
 	}
 	{
 		_v1 := root.Group("/v1", _v1Mw()...)
+		_v1.POST("/chat/completions", append(_chatcompletionsMw(), core_api.ChatCompletions)...)
 		_v1.POST("/completions", append(_completionsMw(), core_api.Completions)...)
+		_v1.GET("/models", append(_listmodelsMw(), core_api.ListModels)...)
 	}
 }
@@ -4,6 +4,7 @@ package core_api
 
 import (
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
 )
 
 func rootMw() []app.HandlerFunc {
@@ -52,8 +53,7 @@ func _completionMw() []app.HandlerFunc {
 }
 
 func _completionsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{adaptor.AllowAPIKey}
 }
 
 func _conversationMw() []app.HandlerFunc {
@@ -230,3 +230,216 @@ func _updateconversationextMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _memoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _clearmemoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletememoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmemoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _newsectionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listbranchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _switchbranchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _forkconversationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportconversationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportallMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getexportstatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _importconversationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _shareMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _continueshareMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createshareMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listshareMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokeshareMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _viewshareMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchmessageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _folderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _pinconversationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _moveconversationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _tagconversationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listtagMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createfolderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listfolderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _renamefolderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletefolderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listtrashMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _restoreconversationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _chatcompletionsMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.AllowAPIKey}
+}
+
+func _listmodelsMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.AllowAPIKey}
+}
+
+func _apikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createapikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listapikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokeapikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateapikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _usagestatisticMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _usageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _topusageuserMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _activestatisticMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _messagestatisticMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _retentionstatisticMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -103,4 +103,33 @@ type EventSearchCite struct {
 	DatePublished string `json:"datePublished" bson:"datePublished"`
 }
 
+// EventTool 工具调用事件
+type EventTool struct {
+	CallId    string `json:"callId"`
+	Name      string `json:"name"`
+	Arguments string `json:"arguments,omitempty"`
+	Result    string `json:"result,omitempty"`
+}
+
+// EventCiteUsed 引用使用事件, 将回答中的引用标记关联到引用索引
+type EventCiteUsed struct {
+	Index int32 `json:"index"` // 引用索引
+	Start int   `json:"start"` // 标记在回答文本中的起始位置(字符)
+	End   int   `json:"end"`   // 标记在回答文本中的结束位置(字符, 不含)
+	Valid bool  `json:"valid"` // 引用是否存在, 为false时是模型编造的引用
+}
+
+// EventTitle 对话标题事件, 自动生成或更新标题后发送
+type EventTitle struct {
+	ConversationId string `json:"conversationId"`
+	Brief          string `json:"brief"`
+}
+
+// EventQuota 剩余配额事件, 本轮用量计入配额后发送, -1表示不限制
+type EventQuota struct {
+	Requests      int64 `json:"requests"`      // 当前窗口剩余的请求数
+	DailyTokens   int64 `json:"dailyTokens"`   // 今日剩余的token数
+	MonthlyTokens int64 `json:"monthlyTokens"` // 本月剩余的token数
+}
+
 type EventEnd struct{}
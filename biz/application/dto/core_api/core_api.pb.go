@@ -31,7 +31,7 @@ var file_core_api_proto_rawDesc = []byte{
 	0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10,
 	0x62, 0x61, 0x73, 0x69, 0x63, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x15, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xd3, 0x11, 0x0a, 0x07, 0x43, 0x6f, 0x72, 0x65,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xdd, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x72, 0x65,
 	0x41, 0x70, 0x69, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f,
 	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63,
@@ -172,185 +172,547 @@ var file_core_api_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61,
 	0x70, 0x69, 0x2e, 0x54, 0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x6f, 0x67,
 	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xd2, 0xc1, 0x18, 0x11, 0x2f, 0x74, 0x68, 0x69,
-	0x72, 0x64, 0x70, 0x61, 0x72, 0x74, 0x79, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x32, 0xe3, 0x01,
-	0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x70,
-	0x69, 0x12, 0x69, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
-	0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x6c,
-	0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xd2, 0xc1,
-	0x18, 0x11, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2f,
-	0x67, 0x65, 0x74, 0x32, 0xbf, 0x03, 0x0a, 0x09, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x41, 0x70,
-	0x69, 0x12, 0x4d, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
-	0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x10,
-	0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x1a, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x56, 0x0a,
-	0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x6f,
-	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x6f, 0x72, 0x62,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x5b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65,
-	0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x18,
-	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64,
-	0x42, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x61, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a,
-	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15,
+	0x72, 0x64, 0x70, 0x61, 0x72, 0x74, 0x79, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x51, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x10,
+	0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x59, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e, 0x2f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x0b, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x11, 0xd2, 0xc1, 0x18, 0x0d, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x2f, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x12, 0x5e, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x65, 0x77, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x1d, 0xd2, 0xc1, 0x18, 0x19, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x12, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x1d, 0xd2, 0xc1, 0x18, 0x19, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x12, 0x66, 0x0a, 0x0c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2, 0xc1, 0x18, 0x1b, 0x2f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x69, 0x0a, 0x10, 0x46, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2,
+	0xc1, 0x18, 0x12, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x66, 0x6f, 0x72, 0x6b, 0x12, 0x71, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18,
+	0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5a, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xd2, 0xc1, 0x18, 0x18, 0x2f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x6f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2, 0xc1, 0x18, 0x1b, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x71, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18,
+	0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0xd2, 0xc1,
+	0x18, 0x0d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x4d, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x16, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0xd2,
+	0xc1, 0x18, 0x0b, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x55,
+	0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x18, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x11, 0xd2, 0xc1, 0x18, 0x0d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x4d, 0x0a, 0x09, 0x56, 0x69, 0x65, 0x77, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69,
+	0x65, 0x77, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x0f, 0xd2, 0xc1, 0x18, 0x0b, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x5d, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x69, 0x6e, 0x75, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13,
+	0xd2, 0xc1, 0x18, 0x0f, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x20, 0xd2,
+	0xc1, 0x18, 0x1c, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x65, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x15, 0xd2, 0xc1, 0x18, 0x11, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x70, 0x69, 0x6e, 0x12, 0x69, 0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x6f, 0x76,
+	0x65, 0x12, 0x65, 0x0a, 0x0f, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x61, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61,
+	0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x15, 0xd2, 0xc1, 0x18, 0x11, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x61, 0x67, 0x12, 0x52, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x61, 0x67, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x12, 0x59, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e, 0x2f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x10, 0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0c, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e, 0x2f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x2f, 0x72,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x12, 0xd2, 0xc1,
+	0x18, 0x0e, 0x2f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x5a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x16, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c,
+	0xd2, 0xc1, 0x18, 0x18, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x12, 0x75, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1a,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e,
+	0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x51,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x10, 0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x2f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x59, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e, 0x2f, 0x61,
+	0x70, 0x69, 0x6b, 0x65, 0x79, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x59, 0x0a, 0x0c,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79,
+	0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x32, 0xe3, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65,
+	0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x70, 0x69, 0x12, 0x69, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16,
+	0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xd2, 0xc1, 0x18, 0x11, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x32, 0xdf, 0x07,
+	0x0a, 0x09, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x41, 0x70, 0x69, 0x12, 0x4d, 0x0a, 0x0a, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x10, 0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x19,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
+	0x5b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x17, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65,
+	0x64, 0x42, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x0d,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x19, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x65, 0x0a, 0x0e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x2f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x66, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e,
+	0x54, 0x6f, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x6f, 0x70, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1, 0x18, 0x1f, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x69,
+	0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1b, 0xd2, 0xc1,
+	0x18, 0x17, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1c, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xd2, 0xc1, 0x18, 0x18,
 	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x32, 0x6e, 0x0a, 0x09, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41,
-	0x70, 0x69, 0x12, 0x61, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55,
-	0x52, 0x4c, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
-	0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67, 0x65, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x68, 0x2d, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x69,
-	0x6e, 0x6e, 0x6f, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2d, 0x61, 0x70,
-	0x69, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x64, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x75, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1e,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x1e, 0xd2, 0xc1, 0x18, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x2f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x32,
+	0x6e, 0x0a, 0x09, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x70, 0x69, 0x12, 0x61, 0x0a, 0x0c,
+	0x47, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2f, 0x67, 0x65, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x42,
+	0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x68,
+	0x2d, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x69, 0x6e, 0x6e, 0x6f, 0x73, 0x70, 0x61,
+	0x72, 0x6b, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x7a, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_core_api_proto_goTypes = []interface{}{
-	(*CompletionsReq)(nil),             // 0: core_api.CompletionsReq
-	(*CreateConversationReq)(nil),      // 1: core_api.CreateConversationReq
-	(*ListConversationReq)(nil),        // 2: core_api.ListConversationReq
-	(*GetConversationReq)(nil),         // 3: core_api.GetConversationReq
-	(*GetConversationExtReq)(nil),      // 4: core_api.GetConversationExtReq
-	(*UpdateConversationExtReq)(nil),   // 5: core_api.UpdateConversationExtReq
-	(*GenerateBriefReq)(nil),           // 6: core_api.GenerateBriefReq
-	(*RenameConversationReq)(nil),      // 7: core_api.RenameConversationReq
-	(*DeleteConversationReq)(nil),      // 8: core_api.DeleteConversationReq
-	(*SearchConversationReq)(nil),      // 9: core_api.SearchConversationReq
-	(*ListAgentsReq)(nil),              // 10: core_api.ListAgentsReq
-	(*FeedbackReq)(nil),                // 11: core_api.FeedbackReq
-	(*SendVerifyCodeReq)(nil),          // 12: core_api.SendVerifyCodeReq
-	(*CheckVerifyCodeReq)(nil),         // 13: core_api.CheckVerifyCodeReq
-	(*BasicUserRegisterReq)(nil),       // 14: core_api.BasicUserRegisterReq
-	(*BasicUserLoginReq)(nil),          // 15: core_api.BasicUserLoginReq
-	(*BasicUserResetPasswordReq)(nil),  // 16: core_api.BasicUserResetPasswordReq
-	(*BasicUserUpdateProfileReq)(nil),  // 17: core_api.BasicUserUpdateProfileReq
-	(*BasicUserGetProfileReq)(nil),     // 18: core_api.BasicUserGetProfileReq
-	(*ThirdPartyLoginReq)(nil),         // 19: core_api.ThirdPartyLoginReq
-	(*ListIntelligenceReq)(nil),        // 20: core_api.ListIntelligenceReq
-	(*GetIntelligenceReq)(nil),         // 21: core_api.GetIntelligenceReq
-	(*manage.AdminLoginReq)(nil),       // 22: manage.AdminLoginReq
-	(*manage.ListUserReq)(nil),         // 23: manage.ListUserReq
-	(*manage.ForbiddenUserReq)(nil),    // 24: manage.ForbiddenUserReq
-	(*manage.ListFeedBackReq)(nil),     // 25: manage.ListFeedBackReq
-	(*manage.UserStatisticsReq)(nil),   // 26: manage.UserStatisticsReq
-	(*GenSignedURLReq)(nil),            // 27: core_api.GenSignedURLReq
-	(*SSEEvent)(nil),                   // 28: core_api.SSEEvent
-	(*CreateConversationResp)(nil),     // 29: core_api.CreateConversationResp
-	(*ListConversationResp)(nil),       // 30: core_api.ListConversationResp
-	(*GetConversationResp)(nil),        // 31: core_api.GetConversationResp
-	(*GetConversationExtResp)(nil),     // 32: core_api.GetConversationExtResp
-	(*UpdateConversationExtResp)(nil),  // 33: core_api.UpdateConversationExtResp
-	(*GenerateBriefResp)(nil),          // 34: core_api.GenerateBriefResp
-	(*RenameConversationResp)(nil),     // 35: core_api.RenameConversationResp
-	(*DeleteConversationResp)(nil),     // 36: core_api.DeleteConversationResp
-	(*SearchConversationResp)(nil),     // 37: core_api.SearchConversationResp
-	(*ListAgentsResp)(nil),             // 38: core_api.ListAgentsResp
-	(*FeedbackResp)(nil),               // 39: core_api.FeedbackResp
-	(*SendVerifyCodeResp)(nil),         // 40: core_api.SendVerifyCodeResp
-	(*CheckVerifyCodeResp)(nil),        // 41: core_api.CheckVerifyCodeResp
-	(*BasicUserRegisterResp)(nil),      // 42: core_api.BasicUserRegisterResp
-	(*BasicUserLoginResp)(nil),         // 43: core_api.BasicUserLoginResp
-	(*BasicUserUpdateProfileResp)(nil), // 44: core_api.BasicUserUpdateProfileResp
-	(*BasicUserGetProfileResp)(nil),    // 45: core_api.BasicUserGetProfileResp
-	(*ThirdPartyLoginResp)(nil),        // 46: core_api.ThirdPartyLoginResp
-	(*ListIntelligenceResp)(nil),       // 47: core_api.ListIntelligenceResp
-	(*GetIntelligenceResp)(nil),        // 48: core_api.GetIntelligenceResp
-	(*manage.AdminLoginResp)(nil),      // 49: manage.AdminLoginResp
-	(*manage.ListUserResp)(nil),        // 50: manage.ListUserResp
-	(*manage.ForbiddenUserResp)(nil),   // 51: manage.ForbiddenUserResp
-	(*manage.ListFeedBackResp)(nil),    // 52: manage.ListFeedBackResp
-	(*manage.UserStatisticsResp)(nil),  // 53: manage.UserStatisticsResp
-	(*GenSignedURLResp)(nil),           // 54: core_api.GenSignedURLResp
+	(*CompletionsReq)(nil),                 // 0: core_api.CompletionsReq
+	(*CreateConversationReq)(nil),          // 1: core_api.CreateConversationReq
+	(*ListConversationReq)(nil),            // 2: core_api.ListConversationReq
+	(*GetConversationReq)(nil),             // 3: core_api.GetConversationReq
+	(*GetConversationExtReq)(nil),          // 4: core_api.GetConversationExtReq
+	(*UpdateConversationExtReq)(nil),       // 5: core_api.UpdateConversationExtReq
+	(*GenerateBriefReq)(nil),               // 6: core_api.GenerateBriefReq
+	(*RenameConversationReq)(nil),          // 7: core_api.RenameConversationReq
+	(*DeleteConversationReq)(nil),          // 8: core_api.DeleteConversationReq
+	(*SearchConversationReq)(nil),          // 9: core_api.SearchConversationReq
+	(*ListAgentsReq)(nil),                  // 10: core_api.ListAgentsReq
+	(*FeedbackReq)(nil),                    // 11: core_api.FeedbackReq
+	(*SendVerifyCodeReq)(nil),              // 12: core_api.SendVerifyCodeReq
+	(*CheckVerifyCodeReq)(nil),             // 13: core_api.CheckVerifyCodeReq
+	(*BasicUserRegisterReq)(nil),           // 14: core_api.BasicUserRegisterReq
+	(*BasicUserLoginReq)(nil),              // 15: core_api.BasicUserLoginReq
+	(*BasicUserResetPasswordReq)(nil),      // 16: core_api.BasicUserResetPasswordReq
+	(*BasicUserUpdateProfileReq)(nil),      // 17: core_api.BasicUserUpdateProfileReq
+	(*BasicUserGetProfileReq)(nil),         // 18: core_api.BasicUserGetProfileReq
+	(*ThirdPartyLoginReq)(nil),             // 19: core_api.ThirdPartyLoginReq
+	(*ListMemoryReq)(nil),                  // 20: core_api.ListMemoryReq
+	(*DeleteMemoryReq)(nil),                // 21: core_api.DeleteMemoryReq
+	(*ClearMemoryReq)(nil),                 // 22: core_api.ClearMemoryReq
+	(*NewSectionReq)(nil),                  // 23: core_api.NewSectionReq
+	(*ListBranchReq)(nil),                  // 24: core_api.ListBranchReq
+	(*SwitchBranchReq)(nil),                // 25: core_api.SwitchBranchReq
+	(*ForkConversationReq)(nil),            // 26: core_api.ForkConversationReq
+	(*ExportConversationReq)(nil),          // 27: core_api.ExportConversationReq
+	(*ExportAllReq)(nil),                   // 28: core_api.ExportAllReq
+	(*GetExportStatusReq)(nil),             // 29: core_api.GetExportStatusReq
+	(*ImportConversationReq)(nil),          // 30: core_api.ImportConversationReq
+	(*CreateShareReq)(nil),                 // 31: core_api.CreateShareReq
+	(*ListShareReq)(nil),                   // 32: core_api.ListShareReq
+	(*RevokeShareReq)(nil),                 // 33: core_api.RevokeShareReq
+	(*ViewShareReq)(nil),                   // 34: core_api.ViewShareReq
+	(*ContinueShareReq)(nil),               // 35: core_api.ContinueShareReq
+	(*SearchMessageReq)(nil),               // 36: core_api.SearchMessageReq
+	(*PinConversationReq)(nil),             // 37: core_api.PinConversationReq
+	(*MoveConversationReq)(nil),            // 38: core_api.MoveConversationReq
+	(*TagConversationReq)(nil),             // 39: core_api.TagConversationReq
+	(*ListTagReq)(nil),                     // 40: core_api.ListTagReq
+	(*CreateFolderReq)(nil),                // 41: core_api.CreateFolderReq
+	(*ListFolderReq)(nil),                  // 42: core_api.ListFolderReq
+	(*RenameFolderReq)(nil),                // 43: core_api.RenameFolderReq
+	(*DeleteFolderReq)(nil),                // 44: core_api.DeleteFolderReq
+	(*ListTrashReq)(nil),                   // 45: core_api.ListTrashReq
+	(*RestoreConversationReq)(nil),         // 46: core_api.RestoreConversationReq
+	(*CreateAPIKeyReq)(nil),                // 47: core_api.CreateAPIKeyReq
+	(*ListAPIKeyReq)(nil),                  // 48: core_api.ListAPIKeyReq
+	(*UpdateAPIKeyReq)(nil),                // 49: core_api.UpdateAPIKeyReq
+	(*RevokeAPIKeyReq)(nil),                // 50: core_api.RevokeAPIKeyReq
+	(*ListIntelligenceReq)(nil),            // 51: core_api.ListIntelligenceReq
+	(*GetIntelligenceReq)(nil),             // 52: core_api.GetIntelligenceReq
+	(*manage.AdminLoginReq)(nil),           // 53: manage.AdminLoginReq
+	(*manage.ListUserReq)(nil),             // 54: manage.ListUserReq
+	(*manage.ForbiddenUserReq)(nil),        // 55: manage.ForbiddenUserReq
+	(*manage.ListFeedBackReq)(nil),         // 56: manage.ListFeedBackReq
+	(*manage.UserStatisticsReq)(nil),       // 57: manage.UserStatisticsReq
+	(*manage.UsageStatisticsReq)(nil),      // 58: manage.UsageStatisticsReq
+	(*manage.TopUsageUserReq)(nil),         // 59: manage.TopUsageUserReq
+	(*manage.ActiveStatisticsReq)(nil),     // 60: manage.ActiveStatisticsReq
+	(*manage.MessageStatisticsReq)(nil),    // 61: manage.MessageStatisticsReq
+	(*manage.RetentionStatisticsReq)(nil),  // 62: manage.RetentionStatisticsReq
+	(*GenSignedURLReq)(nil),                // 63: core_api.GenSignedURLReq
+	(*SSEEvent)(nil),                       // 64: core_api.SSEEvent
+	(*CreateConversationResp)(nil),         // 65: core_api.CreateConversationResp
+	(*ListConversationResp)(nil),           // 66: core_api.ListConversationResp
+	(*GetConversationResp)(nil),            // 67: core_api.GetConversationResp
+	(*GetConversationExtResp)(nil),         // 68: core_api.GetConversationExtResp
+	(*UpdateConversationExtResp)(nil),      // 69: core_api.UpdateConversationExtResp
+	(*GenerateBriefResp)(nil),              // 70: core_api.GenerateBriefResp
+	(*RenameConversationResp)(nil),         // 71: core_api.RenameConversationResp
+	(*DeleteConversationResp)(nil),         // 72: core_api.DeleteConversationResp
+	(*SearchConversationResp)(nil),         // 73: core_api.SearchConversationResp
+	(*ListAgentsResp)(nil),                 // 74: core_api.ListAgentsResp
+	(*FeedbackResp)(nil),                   // 75: core_api.FeedbackResp
+	(*SendVerifyCodeResp)(nil),             // 76: core_api.SendVerifyCodeResp
+	(*CheckVerifyCodeResp)(nil),            // 77: core_api.CheckVerifyCodeResp
+	(*BasicUserRegisterResp)(nil),          // 78: core_api.BasicUserRegisterResp
+	(*BasicUserLoginResp)(nil),             // 79: core_api.BasicUserLoginResp
+	(*BasicUserUpdateProfileResp)(nil),     // 80: core_api.BasicUserUpdateProfileResp
+	(*BasicUserGetProfileResp)(nil),        // 81: core_api.BasicUserGetProfileResp
+	(*ThirdPartyLoginResp)(nil),            // 82: core_api.ThirdPartyLoginResp
+	(*ListMemoryResp)(nil),                 // 83: core_api.ListMemoryResp
+	(*DeleteMemoryResp)(nil),               // 84: core_api.DeleteMemoryResp
+	(*ClearMemoryResp)(nil),                // 85: core_api.ClearMemoryResp
+	(*NewSectionResp)(nil),                 // 86: core_api.NewSectionResp
+	(*ListBranchResp)(nil),                 // 87: core_api.ListBranchResp
+	(*SwitchBranchResp)(nil),               // 88: core_api.SwitchBranchResp
+	(*ForkConversationResp)(nil),           // 89: core_api.ForkConversationResp
+	(*ExportConversationResp)(nil),         // 90: core_api.ExportConversationResp
+	(*ExportAllResp)(nil),                  // 91: core_api.ExportAllResp
+	(*GetExportStatusResp)(nil),            // 92: core_api.GetExportStatusResp
+	(*ImportConversationResp)(nil),         // 93: core_api.ImportConversationResp
+	(*CreateShareResp)(nil),                // 94: core_api.CreateShareResp
+	(*ListShareResp)(nil),                  // 95: core_api.ListShareResp
+	(*RevokeShareResp)(nil),                // 96: core_api.RevokeShareResp
+	(*ViewShareResp)(nil),                  // 97: core_api.ViewShareResp
+	(*ContinueShareResp)(nil),              // 98: core_api.ContinueShareResp
+	(*SearchMessageResp)(nil),              // 99: core_api.SearchMessageResp
+	(*PinConversationResp)(nil),            // 100: core_api.PinConversationResp
+	(*MoveConversationResp)(nil),           // 101: core_api.MoveConversationResp
+	(*TagConversationResp)(nil),            // 102: core_api.TagConversationResp
+	(*ListTagResp)(nil),                    // 103: core_api.ListTagResp
+	(*CreateFolderResp)(nil),               // 104: core_api.CreateFolderResp
+	(*ListFolderResp)(nil),                 // 105: core_api.ListFolderResp
+	(*RenameFolderResp)(nil),               // 106: core_api.RenameFolderResp
+	(*DeleteFolderResp)(nil),               // 107: core_api.DeleteFolderResp
+	(*ListTrashResp)(nil),                  // 108: core_api.ListTrashResp
+	(*RestoreConversationResp)(nil),        // 109: core_api.RestoreConversationResp
+	(*CreateAPIKeyResp)(nil),               // 110: core_api.CreateAPIKeyResp
+	(*ListAPIKeyResp)(nil),                 // 111: core_api.ListAPIKeyResp
+	(*UpdateAPIKeyResp)(nil),               // 112: core_api.UpdateAPIKeyResp
+	(*RevokeAPIKeyResp)(nil),               // 113: core_api.RevokeAPIKeyResp
+	(*ListIntelligenceResp)(nil),           // 114: core_api.ListIntelligenceResp
+	(*GetIntelligenceResp)(nil),            // 115: core_api.GetIntelligenceResp
+	(*manage.AdminLoginResp)(nil),          // 116: manage.AdminLoginResp
+	(*manage.ListUserResp)(nil),            // 117: manage.ListUserResp
+	(*manage.ForbiddenUserResp)(nil),       // 118: manage.ForbiddenUserResp
+	(*manage.ListFeedBackResp)(nil),        // 119: manage.ListFeedBackResp
+	(*manage.UserStatisticsResp)(nil),      // 120: manage.UserStatisticsResp
+	(*manage.UsageStatisticsResp)(nil),     // 121: manage.UsageStatisticsResp
+	(*manage.TopUsageUserResp)(nil),        // 122: manage.TopUsageUserResp
+	(*manage.ActiveStatisticsResp)(nil),    // 123: manage.ActiveStatisticsResp
+	(*manage.MessageStatisticsResp)(nil),   // 124: manage.MessageStatisticsResp
+	(*manage.RetentionStatisticsResp)(nil), // 125: manage.RetentionStatisticsResp
+	(*GenSignedURLResp)(nil),               // 126: core_api.GenSignedURLResp
 }
 var file_core_api_proto_depIdxs = []int32{
-	0,  // 0: core_api.CoreApi.Completions:input_type -> core_api.CompletionsReq
-	1,  // 1: core_api.CoreApi.CreateConversation:input_type -> core_api.CreateConversationReq
-	2,  // 2: core_api.CoreApi.ListConversation:input_type -> core_api.ListConversationReq
-	3,  // 3: core_api.CoreApi.GetConversation:input_type -> core_api.GetConversationReq
-	4,  // 4: core_api.CoreApi.GetConversationExt:input_type -> core_api.GetConversationExtReq
-	5,  // 5: core_api.CoreApi.UpdateConversationExt:input_type -> core_api.UpdateConversationExtReq
-	6,  // 6: core_api.CoreApi.Generate:input_type -> core_api.GenerateBriefReq
-	7,  // 7: core_api.CoreApi.RenameConversation:input_type -> core_api.RenameConversationReq
-	8,  // 8: core_api.CoreApi.DeleteConversation:input_type -> core_api.DeleteConversationReq
-	9,  // 9: core_api.CoreApi.SearchConversation:input_type -> core_api.SearchConversationReq
-	10, // 10: core_api.CoreApi.ListAgents:input_type -> core_api.ListAgentsReq
-	11, // 11: core_api.CoreApi.Feedback:input_type -> core_api.FeedbackReq
-	11, // 12: core_api.CoreApi.FeedbackContent:input_type -> core_api.FeedbackReq
-	12, // 13: core_api.CoreApi.SendVerifyCode:input_type -> core_api.SendVerifyCodeReq
-	13, // 14: core_api.CoreApi.CheckVerifyCode:input_type -> core_api.CheckVerifyCodeReq
-	14, // 15: core_api.CoreApi.BasicUserRegister:input_type -> core_api.BasicUserRegisterReq
-	15, // 16: core_api.CoreApi.BasicUserLogin:input_type -> core_api.BasicUserLoginReq
-	16, // 17: core_api.CoreApi.BasicUserResetPassword:input_type -> core_api.BasicUserResetPasswordReq
-	17, // 18: core_api.CoreApi.BasicUserUpdateProfile:input_type -> core_api.BasicUserUpdateProfileReq
-	18, // 19: core_api.CoreApi.BasicUserGetProfile:input_type -> core_api.BasicUserGetProfileReq
-	19, // 20: core_api.CoreApi.ThirdPartyLogin:input_type -> core_api.ThirdPartyLoginReq
-	20, // 21: core_api.IntelligenceApi.ListIntelligence:input_type -> core_api.ListIntelligenceReq
-	21, // 22: core_api.IntelligenceApi.GetIntelligence:input_type -> core_api.GetIntelligenceReq
-	22, // 23: core_api.ManageApi.AdminLogin:input_type -> manage.AdminLoginReq
-	23, // 24: core_api.ManageApi.ListUser:input_type -> manage.ListUserReq
-	24, // 25: core_api.ManageApi.Forbidden:input_type -> manage.ForbiddenUserReq
-	25, // 26: core_api.ManageApi.ListFeedback:input_type -> manage.ListFeedBackReq
-	26, // 27: core_api.ManageApi.UserStatistic:input_type -> manage.UserStatisticsReq
-	27, // 28: core_api.SystemApi.GenSignedURL:input_type -> core_api.GenSignedURLReq
-	28, // 29: core_api.CoreApi.Completions:output_type -> core_api.SSEEvent
-	29, // 30: core_api.CoreApi.CreateConversation:output_type -> core_api.CreateConversationResp
-	30, // 31: core_api.CoreApi.ListConversation:output_type -> core_api.ListConversationResp
-	31, // 32: core_api.CoreApi.GetConversation:output_type -> core_api.GetConversationResp
-	32, // 33: core_api.CoreApi.GetConversationExt:output_type -> core_api.GetConversationExtResp
-	33, // 34: core_api.CoreApi.UpdateConversationExt:output_type -> core_api.UpdateConversationExtResp
-	34, // 35: core_api.CoreApi.Generate:output_type -> core_api.GenerateBriefResp
-	35, // 36: core_api.CoreApi.RenameConversation:output_type -> core_api.RenameConversationResp
-	36, // 37: core_api.CoreApi.DeleteConversation:output_type -> core_api.DeleteConversationResp
-	37, // 38: core_api.CoreApi.SearchConversation:output_type -> core_api.SearchConversationResp
-	38, // 39: core_api.CoreApi.ListAgents:output_type -> core_api.ListAgentsResp
-	39, // 40: core_api.CoreApi.Feedback:output_type -> core_api.FeedbackResp
-	39, // 41: core_api.CoreApi.FeedbackContent:output_type -> core_api.FeedbackResp
-	40, // 42: core_api.CoreApi.SendVerifyCode:output_type -> core_api.SendVerifyCodeResp
-	41, // 43: core_api.CoreApi.CheckVerifyCode:output_type -> core_api.CheckVerifyCodeResp
-	42, // 44: core_api.CoreApi.BasicUserRegister:output_type -> core_api.BasicUserRegisterResp
-	43, // 45: core_api.CoreApi.BasicUserLogin:output_type -> core_api.BasicUserLoginResp
-	42, // 46: core_api.CoreApi.BasicUserResetPassword:output_type -> core_api.BasicUserRegisterResp
-	44, // 47: core_api.CoreApi.BasicUserUpdateProfile:output_type -> core_api.BasicUserUpdateProfileResp
-	45, // 48: core_api.CoreApi.BasicUserGetProfile:output_type -> core_api.BasicUserGetProfileResp
-	46, // 49: core_api.CoreApi.ThirdPartyLogin:output_type -> core_api.ThirdPartyLoginResp
-	47, // 50: core_api.IntelligenceApi.ListIntelligence:output_type -> core_api.ListIntelligenceResp
-	48, // 51: core_api.IntelligenceApi.GetIntelligence:output_type -> core_api.GetIntelligenceResp
-	49, // 52: core_api.ManageApi.AdminLogin:output_type -> manage.AdminLoginResp
-	50, // 53: core_api.ManageApi.ListUser:output_type -> manage.ListUserResp
-	51, // 54: core_api.ManageApi.Forbidden:output_type -> manage.ForbiddenUserResp
-	52, // 55: core_api.ManageApi.ListFeedback:output_type -> manage.ListFeedBackResp
-	53, // 56: core_api.ManageApi.UserStatistic:output_type -> manage.UserStatisticsResp
-	54, // 57: core_api.SystemApi.GenSignedURL:output_type -> core_api.GenSignedURLResp
-	29, // [29:58] is the sub-list for method output_type
-	0,  // [0:29] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: core_api.CoreApi.Completions:input_type -> core_api.CompletionsReq
+	1,   // 1: core_api.CoreApi.CreateConversation:input_type -> core_api.CreateConversationReq
+	2,   // 2: core_api.CoreApi.ListConversation:input_type -> core_api.ListConversationReq
+	3,   // 3: core_api.CoreApi.GetConversation:input_type -> core_api.GetConversationReq
+	4,   // 4: core_api.CoreApi.GetConversationExt:input_type -> core_api.GetConversationExtReq
+	5,   // 5: core_api.CoreApi.UpdateConversationExt:input_type -> core_api.UpdateConversationExtReq
+	6,   // 6: core_api.CoreApi.Generate:input_type -> core_api.GenerateBriefReq
+	7,   // 7: core_api.CoreApi.RenameConversation:input_type -> core_api.RenameConversationReq
+	8,   // 8: core_api.CoreApi.DeleteConversation:input_type -> core_api.DeleteConversationReq
+	9,   // 9: core_api.CoreApi.SearchConversation:input_type -> core_api.SearchConversationReq
+	10,  // 10: core_api.CoreApi.ListAgents:input_type -> core_api.ListAgentsReq
+	11,  // 11: core_api.CoreApi.Feedback:input_type -> core_api.FeedbackReq
+	11,  // 12: core_api.CoreApi.FeedbackContent:input_type -> core_api.FeedbackReq
+	12,  // 13: core_api.CoreApi.SendVerifyCode:input_type -> core_api.SendVerifyCodeReq
+	13,  // 14: core_api.CoreApi.CheckVerifyCode:input_type -> core_api.CheckVerifyCodeReq
+	14,  // 15: core_api.CoreApi.BasicUserRegister:input_type -> core_api.BasicUserRegisterReq
+	15,  // 16: core_api.CoreApi.BasicUserLogin:input_type -> core_api.BasicUserLoginReq
+	16,  // 17: core_api.CoreApi.BasicUserResetPassword:input_type -> core_api.BasicUserResetPasswordReq
+	17,  // 18: core_api.CoreApi.BasicUserUpdateProfile:input_type -> core_api.BasicUserUpdateProfileReq
+	18,  // 19: core_api.CoreApi.BasicUserGetProfile:input_type -> core_api.BasicUserGetProfileReq
+	19,  // 20: core_api.CoreApi.ThirdPartyLogin:input_type -> core_api.ThirdPartyLoginReq
+	20,  // 21: core_api.CoreApi.ListMemory:input_type -> core_api.ListMemoryReq
+	21,  // 22: core_api.CoreApi.DeleteMemory:input_type -> core_api.DeleteMemoryReq
+	22,  // 23: core_api.CoreApi.ClearMemory:input_type -> core_api.ClearMemoryReq
+	23,  // 24: core_api.CoreApi.NewSection:input_type -> core_api.NewSectionReq
+	24,  // 25: core_api.CoreApi.ListBranch:input_type -> core_api.ListBranchReq
+	25,  // 26: core_api.CoreApi.SwitchBranch:input_type -> core_api.SwitchBranchReq
+	26,  // 27: core_api.CoreApi.ForkConversation:input_type -> core_api.ForkConversationReq
+	27,  // 28: core_api.CoreApi.ExportConversation:input_type -> core_api.ExportConversationReq
+	28,  // 29: core_api.CoreApi.ExportAll:input_type -> core_api.ExportAllReq
+	29,  // 30: core_api.CoreApi.GetExportStatus:input_type -> core_api.GetExportStatusReq
+	30,  // 31: core_api.CoreApi.ImportConversation:input_type -> core_api.ImportConversationReq
+	31,  // 32: core_api.CoreApi.CreateShare:input_type -> core_api.CreateShareReq
+	32,  // 33: core_api.CoreApi.ListShare:input_type -> core_api.ListShareReq
+	33,  // 34: core_api.CoreApi.RevokeShare:input_type -> core_api.RevokeShareReq
+	34,  // 35: core_api.CoreApi.ViewShare:input_type -> core_api.ViewShareReq
+	35,  // 36: core_api.CoreApi.ContinueShare:input_type -> core_api.ContinueShareReq
+	36,  // 37: core_api.CoreApi.SearchMessage:input_type -> core_api.SearchMessageReq
+	37,  // 38: core_api.CoreApi.PinConversation:input_type -> core_api.PinConversationReq
+	38,  // 39: core_api.CoreApi.MoveConversation:input_type -> core_api.MoveConversationReq
+	39,  // 40: core_api.CoreApi.TagConversation:input_type -> core_api.TagConversationReq
+	40,  // 41: core_api.CoreApi.ListTag:input_type -> core_api.ListTagReq
+	41,  // 42: core_api.CoreApi.CreateFolder:input_type -> core_api.CreateFolderReq
+	42,  // 43: core_api.CoreApi.ListFolder:input_type -> core_api.ListFolderReq
+	43,  // 44: core_api.CoreApi.RenameFolder:input_type -> core_api.RenameFolderReq
+	44,  // 45: core_api.CoreApi.DeleteFolder:input_type -> core_api.DeleteFolderReq
+	45,  // 46: core_api.CoreApi.ListTrash:input_type -> core_api.ListTrashReq
+	46,  // 47: core_api.CoreApi.RestoreConversation:input_type -> core_api.RestoreConversationReq
+	47,  // 48: core_api.CoreApi.CreateAPIKey:input_type -> core_api.CreateAPIKeyReq
+	48,  // 49: core_api.CoreApi.ListAPIKey:input_type -> core_api.ListAPIKeyReq
+	49,  // 50: core_api.CoreApi.UpdateAPIKey:input_type -> core_api.UpdateAPIKeyReq
+	50,  // 51: core_api.CoreApi.RevokeAPIKey:input_type -> core_api.RevokeAPIKeyReq
+	51,  // 52: core_api.IntelligenceApi.ListIntelligence:input_type -> core_api.ListIntelligenceReq
+	52,  // 53: core_api.IntelligenceApi.GetIntelligence:input_type -> core_api.GetIntelligenceReq
+	53,  // 54: core_api.ManageApi.AdminLogin:input_type -> manage.AdminLoginReq
+	54,  // 55: core_api.ManageApi.ListUser:input_type -> manage.ListUserReq
+	55,  // 56: core_api.ManageApi.Forbidden:input_type -> manage.ForbiddenUserReq
+	56,  // 57: core_api.ManageApi.ListFeedback:input_type -> manage.ListFeedBackReq
+	57,  // 58: core_api.ManageApi.UserStatistic:input_type -> manage.UserStatisticsReq
+	58,  // 59: core_api.ManageApi.UsageStatistic:input_type -> manage.UsageStatisticsReq
+	59,  // 60: core_api.ManageApi.TopUsageUser:input_type -> manage.TopUsageUserReq
+	60,  // 61: core_api.ManageApi.ActiveStatistic:input_type -> manage.ActiveStatisticsReq
+	61,  // 62: core_api.ManageApi.MessageStatistic:input_type -> manage.MessageStatisticsReq
+	62,  // 63: core_api.ManageApi.RetentionStatistic:input_type -> manage.RetentionStatisticsReq
+	63,  // 64: core_api.SystemApi.GenSignedURL:input_type -> core_api.GenSignedURLReq
+	64,  // 65: core_api.CoreApi.Completions:output_type -> core_api.SSEEvent
+	65,  // 66: core_api.CoreApi.CreateConversation:output_type -> core_api.CreateConversationResp
+	66,  // 67: core_api.CoreApi.ListConversation:output_type -> core_api.ListConversationResp
+	67,  // 68: core_api.CoreApi.GetConversation:output_type -> core_api.GetConversationResp
+	68,  // 69: core_api.CoreApi.GetConversationExt:output_type -> core_api.GetConversationExtResp
+	69,  // 70: core_api.CoreApi.UpdateConversationExt:output_type -> core_api.UpdateConversationExtResp
+	70,  // 71: core_api.CoreApi.Generate:output_type -> core_api.GenerateBriefResp
+	71,  // 72: core_api.CoreApi.RenameConversation:output_type -> core_api.RenameConversationResp
+	72,  // 73: core_api.CoreApi.DeleteConversation:output_type -> core_api.DeleteConversationResp
+	73,  // 74: core_api.CoreApi.SearchConversation:output_type -> core_api.SearchConversationResp
+	74,  // 75: core_api.CoreApi.ListAgents:output_type -> core_api.ListAgentsResp
+	75,  // 76: core_api.CoreApi.Feedback:output_type -> core_api.FeedbackResp
+	75,  // 77: core_api.CoreApi.FeedbackContent:output_type -> core_api.FeedbackResp
+	76,  // 78: core_api.CoreApi.SendVerifyCode:output_type -> core_api.SendVerifyCodeResp
+	77,  // 79: core_api.CoreApi.CheckVerifyCode:output_type -> core_api.CheckVerifyCodeResp
+	78,  // 80: core_api.CoreApi.BasicUserRegister:output_type -> core_api.BasicUserRegisterResp
+	79,  // 81: core_api.CoreApi.BasicUserLogin:output_type -> core_api.BasicUserLoginResp
+	78,  // 82: core_api.CoreApi.BasicUserResetPassword:output_type -> core_api.BasicUserRegisterResp
+	80,  // 83: core_api.CoreApi.BasicUserUpdateProfile:output_type -> core_api.BasicUserUpdateProfileResp
+	81,  // 84: core_api.CoreApi.BasicUserGetProfile:output_type -> core_api.BasicUserGetProfileResp
+	82,  // 85: core_api.CoreApi.ThirdPartyLogin:output_type -> core_api.ThirdPartyLoginResp
+	83,  // 86: core_api.CoreApi.ListMemory:output_type -> core_api.ListMemoryResp
+	84,  // 87: core_api.CoreApi.DeleteMemory:output_type -> core_api.DeleteMemoryResp
+	85,  // 88: core_api.CoreApi.ClearMemory:output_type -> core_api.ClearMemoryResp
+	86,  // 89: core_api.CoreApi.NewSection:output_type -> core_api.NewSectionResp
+	87,  // 90: core_api.CoreApi.ListBranch:output_type -> core_api.ListBranchResp
+	88,  // 91: core_api.CoreApi.SwitchBranch:output_type -> core_api.SwitchBranchResp
+	89,  // 92: core_api.CoreApi.ForkConversation:output_type -> core_api.ForkConversationResp
+	90,  // 93: core_api.CoreApi.ExportConversation:output_type -> core_api.ExportConversationResp
+	91,  // 94: core_api.CoreApi.ExportAll:output_type -> core_api.ExportAllResp
+	92,  // 95: core_api.CoreApi.GetExportStatus:output_type -> core_api.GetExportStatusResp
+	93,  // 96: core_api.CoreApi.ImportConversation:output_type -> core_api.ImportConversationResp
+	94,  // 97: core_api.CoreApi.CreateShare:output_type -> core_api.CreateShareResp
+	95,  // 98: core_api.CoreApi.ListShare:output_type -> core_api.ListShareResp
+	96,  // 99: core_api.CoreApi.RevokeShare:output_type -> core_api.RevokeShareResp
+	97,  // 100: core_api.CoreApi.ViewShare:output_type -> core_api.ViewShareResp
+	98,  // 101: core_api.CoreApi.ContinueShare:output_type -> core_api.ContinueShareResp
+	99,  // 102: core_api.CoreApi.SearchMessage:output_type -> core_api.SearchMessageResp
+	100, // 103: core_api.CoreApi.PinConversation:output_type -> core_api.PinConversationResp
+	101, // 104: core_api.CoreApi.MoveConversation:output_type -> core_api.MoveConversationResp
+	102, // 105: core_api.CoreApi.TagConversation:output_type -> core_api.TagConversationResp
+	103, // 106: core_api.CoreApi.ListTag:output_type -> core_api.ListTagResp
+	104, // 107: core_api.CoreApi.CreateFolder:output_type -> core_api.CreateFolderResp
+	105, // 108: core_api.CoreApi.ListFolder:output_type -> core_api.ListFolderResp
+	106, // 109: core_api.CoreApi.RenameFolder:output_type -> core_api.RenameFolderResp
+	107, // 110: core_api.CoreApi.DeleteFolder:output_type -> core_api.DeleteFolderResp
+	108, // 111: core_api.CoreApi.ListTrash:output_type -> core_api.ListTrashResp
+	109, // 112: core_api.CoreApi.RestoreConversation:output_type -> core_api.RestoreConversationResp
+	110, // 113: core_api.CoreApi.CreateAPIKey:output_type -> core_api.CreateAPIKeyResp
+	111, // 114: core_api.CoreApi.ListAPIKey:output_type -> core_api.ListAPIKeyResp
+	112, // 115: core_api.CoreApi.UpdateAPIKey:output_type -> core_api.UpdateAPIKeyResp
+	113, // 116: core_api.CoreApi.RevokeAPIKey:output_type -> core_api.RevokeAPIKeyResp
+	114, // 117: core_api.IntelligenceApi.ListIntelligence:output_type -> core_api.ListIntelligenceResp
+	115, // 118: core_api.IntelligenceApi.GetIntelligence:output_type -> core_api.GetIntelligenceResp
+	116, // 119: core_api.ManageApi.AdminLogin:output_type -> manage.AdminLoginResp
+	117, // 120: core_api.ManageApi.ListUser:output_type -> manage.ListUserResp
+	118, // 121: core_api.ManageApi.Forbidden:output_type -> manage.ForbiddenUserResp
+	119, // 122: core_api.ManageApi.ListFeedback:output_type -> manage.ListFeedBackResp
+	120, // 123: core_api.ManageApi.UserStatistic:output_type -> manage.UserStatisticsResp
+	121, // 124: core_api.ManageApi.UsageStatistic:output_type -> manage.UsageStatisticsResp
+	122, // 125: core_api.ManageApi.TopUsageUser:output_type -> manage.TopUsageUserResp
+	123, // 126: core_api.ManageApi.ActiveStatistic:output_type -> manage.ActiveStatisticsResp
+	124, // 127: core_api.ManageApi.MessageStatistic:output_type -> manage.MessageStatisticsResp
+	125, // 128: core_api.ManageApi.RetentionStatistic:output_type -> manage.RetentionStatisticsResp
+	126, // 129: core_api.SystemApi.GenSignedURL:output_type -> core_api.GenSignedURLResp
+	65,  // [65:130] is the sub-list for method output_type
+	0,   // [0:65] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func file_core_api_proto_init() {
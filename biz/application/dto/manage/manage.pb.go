@@ -642,8 +642,9 @@ type UserStatisticsReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start int64 `protobuf:"varint,1,opt,name=start,proto3" form:"start" json:"start" query:"start"`
-	End   int64 `protobuf:"varint,2,opt,name=end,proto3" form:"end" json:"end" query:"end"`
+	Start    int64  `protobuf:"varint,1,opt,name=start,proto3" form:"start" json:"start" query:"start"`
+	End      int64  `protobuf:"varint,2,opt,name=end,proto3" form:"end" json:"end" query:"end"`
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" form:"timezone" json:"timezone" query:"timezone"` // 时区, 如Asia/Shanghai, 默认为UTC
 }
 
 func (x *UserStatisticsReq) Reset() {
@@ -692,36 +693,1080 @@ func (x *UserStatisticsReq) GetEnd() int64 {
 	return 0
 }
 
+func (x *UserStatisticsReq) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 type UserStatisticsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Resp               *basic.Response            `protobuf:"bytes,1,opt,name=resp,proto3" form:"resp" json:"resp" query:"resp"`
-	Growth             []*UserStatisticsResp_Item `protobuf:"bytes,2,rep,name=growth,proto3" form:"growth" json:"growth" query:"growth"`                                                   // 柱状图：每日新增用户数据
-	Accumulate         []*UserStatisticsResp_Item `protobuf:"bytes,3,rep,name=accumulate,proto3" form:"accumulate" json:"accumulate" query:"accumulate"`                                   // 折线图：每日累计用户数据
-	Trend              *UserStatisticsResp_Trend  `protobuf:"bytes,4,opt,name=trend,proto3" form:"trend" json:"trend" query:"trend"`                                                       // 直线, 趋势
-	TotalNewUsers      int64                      `protobuf:"varint,5,opt,name=totalNewUsers,proto3" form:"totalNewUsers" json:"totalNewUsers" query:"totalNewUsers"`                      // 统计周期内总新增用户数
-	AverageDailyGrowth float64                    `protobuf:"fixed64,6,opt,name=averageDailyGrowth,proto3" form:"averageDailyGrowth" json:"averageDailyGrowth" query:"averageDailyGrowth"` // 统计周期内的日均新增用户数
+	Resp               *basic.Response            `protobuf:"bytes,1,opt,name=resp,proto3" form:"resp" json:"resp" query:"resp"`
+	Growth             []*UserStatisticsResp_Item `protobuf:"bytes,2,rep,name=growth,proto3" form:"growth" json:"growth" query:"growth"`                                                   // 柱状图：每日新增用户数据
+	Accumulate         []*UserStatisticsResp_Item `protobuf:"bytes,3,rep,name=accumulate,proto3" form:"accumulate" json:"accumulate" query:"accumulate"`                                   // 折线图：每日累计用户数据
+	Trend              *UserStatisticsResp_Trend  `protobuf:"bytes,4,opt,name=trend,proto3" form:"trend" json:"trend" query:"trend"`                                                       // 直线, 趋势
+	TotalNewUsers      int64                      `protobuf:"varint,5,opt,name=totalNewUsers,proto3" form:"totalNewUsers" json:"totalNewUsers" query:"totalNewUsers"`                      // 统计周期内总新增用户数
+	AverageDailyGrowth float64                    `protobuf:"fixed64,6,opt,name=averageDailyGrowth,proto3" form:"averageDailyGrowth" json:"averageDailyGrowth" query:"averageDailyGrowth"` // 统计周期内的日均新增用户数
+}
+
+func (x *UserStatisticsResp) Reset() {
+	*x = UserStatisticsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserStatisticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatisticsResp) ProtoMessage() {}
+
+func (x *UserStatisticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatisticsResp.ProtoReflect.Descriptor instead.
+func (*UserStatisticsResp) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UserStatisticsResp) GetResp() *basic.Response {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *UserStatisticsResp) GetGrowth() []*UserStatisticsResp_Item {
+	if x != nil {
+		return x.Growth
+	}
+	return nil
+}
+
+func (x *UserStatisticsResp) GetAccumulate() []*UserStatisticsResp_Item {
+	if x != nil {
+		return x.Accumulate
+	}
+	return nil
+}
+
+func (x *UserStatisticsResp) GetTrend() *UserStatisticsResp_Trend {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+func (x *UserStatisticsResp) GetTotalNewUsers() int64 {
+	if x != nil {
+		return x.TotalNewUsers
+	}
+	return 0
+}
+
+func (x *UserStatisticsResp) GetAverageDailyGrowth() float64 {
+	if x != nil {
+		return x.AverageDailyGrowth
+	}
+	return 0
+}
+
+// 一个分组的用量
+type UsageItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key              string `protobuf:"bytes,1,opt,name=key,proto3" form:"key" json:"key" query:"key"`                      // 分组的值, 按天分组时为当天0点的时间戳, 按用户分组时为用户id
+	Requests         int64  `protobuf:"varint,2,opt,name=requests,proto3" form:"requests" json:"requests" query:"requests"` // 调用次数
+	PromptTokens     int64  `protobuf:"varint,3,opt,name=promptTokens,proto3" form:"promptTokens" json:"promptTokens" query:"promptTokens"`
+	CompletionTokens int64  `protobuf:"varint,4,opt,name=completionTokens,proto3" form:"completionTokens" json:"completionTokens" query:"completionTokens"`
+	CachedTokens     int64  `protobuf:"varint,5,opt,name=cachedTokens,proto3" form:"cachedTokens" json:"cachedTokens" query:"cachedTokens"`
+	TotalTokens      int64  `protobuf:"varint,6,opt,name=totalTokens,proto3" form:"totalTokens" json:"totalTokens" query:"totalTokens"`
+	SearchCalls      int64  `protobuf:"varint,7,opt,name=searchCalls,proto3" form:"searchCalls" json:"searchCalls" query:"searchCalls"` // 搜索次数
+	OcrCalls         int64  `protobuf:"varint,8,opt,name=ocrCalls,proto3" form:"ocrCalls" json:"ocrCalls" query:"ocrCalls"`             // ocr次数
+	AvgLatency       int64  `protobuf:"varint,9,opt,name=avgLatency,proto3" form:"avgLatency" json:"avgLatency" query:"avgLatency"`     // 平均耗时, 毫秒
+}
+
+func (x *UsageItem) Reset() {
+	*x = UsageItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageItem) ProtoMessage() {}
+
+func (x *UsageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageItem.ProtoReflect.Descriptor instead.
+func (*UsageItem) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UsageItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UsageItem) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *UsageItem) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *UsageItem) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *UsageItem) GetCachedTokens() int64 {
+	if x != nil {
+		return x.CachedTokens
+	}
+	return 0
+}
+
+func (x *UsageItem) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *UsageItem) GetSearchCalls() int64 {
+	if x != nil {
+		return x.SearchCalls
+	}
+	return 0
+}
+
+func (x *UsageItem) GetOcrCalls() int64 {
+	if x != nil {
+		return x.OcrCalls
+	}
+	return 0
+}
+
+func (x *UsageItem) GetAvgLatency() int64 {
+	if x != nil {
+		return x.AvgLatency
+	}
+	return 0
+}
+
+type UsageStatisticsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start   int64   `protobuf:"varint,1,opt,name=start,proto3" form:"start" json:"start" query:"start"`          // 开始时间, 秒级时间戳, 按天对齐
+	End     int64   `protobuf:"varint,2,opt,name=end,proto3" form:"end" json:"end" query:"end"`                  // 结束时间, 秒级时间戳, 包含当天
+	GroupBy string  `protobuf:"bytes,3,opt,name=groupBy,proto3" form:"groupBy" json:"groupBy" query:"groupBy"`   // 分组维度, day/model/user/bot, 默认为day
+	Model   *string `protobuf:"bytes,4,opt,name=model,proto3,oneof" form:"model" json:"model" query:"model"`     // 只统计该模型
+	UserId  *string `protobuf:"bytes,5,opt,name=userId,proto3,oneof" form:"userId" json:"userId" query:"userId"` // 只统计该用户
+	BotId   *string `protobuf:"bytes,6,opt,name=botId,proto3,oneof" form:"botId" json:"botId" query:"botId"`     // 只统计该智能体
+}
+
+func (x *UsageStatisticsReq) Reset() {
+	*x = UsageStatisticsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageStatisticsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageStatisticsReq) ProtoMessage() {}
+
+func (x *UsageStatisticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageStatisticsReq.ProtoReflect.Descriptor instead.
+func (*UsageStatisticsReq) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UsageStatisticsReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *UsageStatisticsReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *UsageStatisticsReq) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *UsageStatisticsReq) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *UsageStatisticsReq) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *UsageStatisticsReq) GetBotId() string {
+	if x != nil && x.BotId != nil {
+		return *x.BotId
+	}
+	return ""
+}
+
+type UsageStatisticsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resp  *basic.Response `protobuf:"bytes,1,opt,name=resp,proto3" form:"resp" json:"resp" query:"resp"`
+	Items []*UsageItem    `protobuf:"bytes,2,rep,name=items,proto3" form:"items" json:"items" query:"items"`
+	Total *UsageItem      `protobuf:"bytes,3,opt,name=total,proto3" form:"total" json:"total" query:"total"` // 统计周期内的合计
+}
+
+func (x *UsageStatisticsResp) Reset() {
+	*x = UsageStatisticsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageStatisticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageStatisticsResp) ProtoMessage() {}
+
+func (x *UsageStatisticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageStatisticsResp.ProtoReflect.Descriptor instead.
+func (*UsageStatisticsResp) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UsageStatisticsResp) GetResp() *basic.Response {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *UsageStatisticsResp) GetItems() []*UsageItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UsageStatisticsResp) GetTotal() *UsageItem {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// 用户的用量
+type UserUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User  *User      `protobuf:"bytes,1,opt,name=user,proto3" form:"user" json:"user" query:"user"`
+	Usage *UsageItem `protobuf:"bytes,2,opt,name=usage,proto3" form:"usage" json:"usage" query:"usage"`
+}
+
+func (x *UserUsage) Reset() {
+	*x = UserUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUsage) ProtoMessage() {}
+
+func (x *UserUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUsage.ProtoReflect.Descriptor instead.
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserUsage) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserUsage) GetUsage() *UsageItem {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type TopUsageUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64   `protobuf:"varint,1,opt,name=start,proto3" form:"start" json:"start" query:"start"`      // 开始时间, 秒级时间戳, 按天对齐
+	End   int64   `protobuf:"varint,2,opt,name=end,proto3" form:"end" json:"end" query:"end"`              // 结束时间, 秒级时间戳, 包含当天
+	Model *string `protobuf:"bytes,3,opt,name=model,proto3,oneof" form:"model" json:"model" query:"model"` // 只统计该模型
+	BotId *string `protobuf:"bytes,4,opt,name=botId,proto3,oneof" form:"botId" json:"botId" query:"botId"` // 只统计该智能体
+	Limit int64   `protobuf:"varint,5,opt,name=limit,proto3" form:"limit" json:"limit" query:"limit"`      // 返回的用户数, 默认为10, 最多100
+}
+
+func (x *TopUsageUserReq) Reset() {
+	*x = TopUsageUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopUsageUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUsageUserReq) ProtoMessage() {}
+
+func (x *TopUsageUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUsageUserReq.ProtoReflect.Descriptor instead.
+func (*TopUsageUserReq) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TopUsageUserReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TopUsageUserReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *TopUsageUserReq) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *TopUsageUserReq) GetBotId() string {
+	if x != nil && x.BotId != nil {
+		return *x.BotId
+	}
+	return ""
+}
+
+func (x *TopUsageUserReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TopUsageUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resp  *basic.Response `protobuf:"bytes,1,opt,name=resp,proto3" form:"resp" json:"resp" query:"resp"`
+	Users []*UserUsage    `protobuf:"bytes,2,rep,name=users,proto3" form:"users" json:"users" query:"users"` // 按总token数倒序
+}
+
+func (x *TopUsageUserResp) Reset() {
+	*x = TopUsageUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopUsageUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUsageUserResp) ProtoMessage() {}
+
+func (x *TopUsageUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUsageUserResp.ProtoReflect.Descriptor instead.
+func (*TopUsageUserResp) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TopUsageUserResp) GetResp() *basic.Response {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *TopUsageUserResp) GetUsers() []*UserUsage {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// 一个统计周期的计数
+type DateCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date  int64 `protobuf:"varint,1,opt,name=date,proto3" form:"date" json:"date" query:"date"` // 周期第一天0点的时间戳
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" form:"count" json:"count" query:"count"`
+}
+
+func (x *DateCount) Reset() {
+	*x = DateCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateCount) ProtoMessage() {}
+
+func (x *DateCount) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateCount.ProtoReflect.Descriptor instead.
+func (*DateCount) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DateCount) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *DateCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ActiveStatisticsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    int64  `protobuf:"varint,1,opt,name=start,proto3" form:"start" json:"start" query:"start"`            // 开始时间, 秒级时间戳
+	End      int64  `protobuf:"varint,2,opt,name=end,proto3" form:"end" json:"end" query:"end"`                    // 结束时间, 秒级时间戳
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" form:"timezone" json:"timezone" query:"timezone"` // 时区, 默认为Asia/Shanghai
+}
+
+func (x *ActiveStatisticsReq) Reset() {
+	*x = ActiveStatisticsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveStatisticsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveStatisticsReq) ProtoMessage() {}
+
+func (x *ActiveStatisticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveStatisticsReq.ProtoReflect.Descriptor instead.
+func (*ActiveStatisticsReq) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ActiveStatisticsReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ActiveStatisticsReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ActiveStatisticsReq) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type ActiveStatisticsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resp       *basic.Response `protobuf:"bytes,1,opt,name=resp,proto3" form:"resp" json:"resp" query:"resp"`
+	Dau        []*DateCount    `protobuf:"bytes,2,rep,name=dau,proto3" form:"dau" json:"dau" query:"dau"`                               // 日活
+	Wau        []*DateCount    `protobuf:"bytes,3,rep,name=wau,proto3" form:"wau" json:"wau" query:"wau"`                               // 周活, 以周一为一周的开始
+	Mau        []*DateCount    `protobuf:"bytes,4,rep,name=mau,proto3" form:"mau" json:"mau" query:"mau"`                               // 月活
+	AverageDau float64         `protobuf:"fixed64,5,opt,name=averageDau,proto3" form:"averageDau" json:"averageDau" query:"averageDau"` // 统计周期内的平均日活
+	Stickiness float64         `protobuf:"fixed64,6,opt,name=stickiness,proto3" form:"stickiness" json:"stickiness" query:"stickiness"` // 粘性, 平均日活/平均月活
+}
+
+func (x *ActiveStatisticsResp) Reset() {
+	*x = ActiveStatisticsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveStatisticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveStatisticsResp) ProtoMessage() {}
+
+func (x *ActiveStatisticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveStatisticsResp.ProtoReflect.Descriptor instead.
+func (*ActiveStatisticsResp) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ActiveStatisticsResp) GetResp() *basic.Response {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *ActiveStatisticsResp) GetDau() []*DateCount {
+	if x != nil {
+		return x.Dau
+	}
+	return nil
+}
+
+func (x *ActiveStatisticsResp) GetWau() []*DateCount {
+	if x != nil {
+		return x.Wau
+	}
+	return nil
+}
+
+func (x *ActiveStatisticsResp) GetMau() []*DateCount {
+	if x != nil {
+		return x.Mau
+	}
+	return nil
+}
+
+func (x *ActiveStatisticsResp) GetAverageDau() float64 {
+	if x != nil {
+		return x.AverageDau
+	}
+	return 0
+}
+
+func (x *ActiveStatisticsResp) GetStickiness() float64 {
+	if x != nil {
+		return x.Stickiness
+	}
+	return 0
+}
+
+// 同一周注册的用户
+type RetentionCohort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Week     int64     `protobuf:"varint,1,opt,name=week,proto3" form:"week" json:"week" query:"week"`                        // 注册当周周一0点的时间戳
+	Users    int64     `protobuf:"varint,2,opt,name=users,proto3" form:"users" json:"users" query:"users"`                    // 注册人数
+	Retained []int64   `protobuf:"varint,3,rep,packed,name=retained,proto3" form:"retained" json:"retained" query:"retained"` // 注册后第i周发送过消息的人数, 第0周为注册当周
+	Rates    []float64 `protobuf:"fixed64,4,rep,packed,name=rates,proto3" form:"rates" json:"rates" query:"rates"`            // 对应的留存率
+}
+
+func (x *RetentionCohort) Reset() {
+	*x = RetentionCohort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionCohort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionCohort) ProtoMessage() {}
+
+func (x *RetentionCohort) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionCohort.ProtoReflect.Descriptor instead.
+func (*RetentionCohort) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RetentionCohort) GetWeek() int64 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *RetentionCohort) GetUsers() int64 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *RetentionCohort) GetRetained() []int64 {
+	if x != nil {
+		return x.Retained
+	}
+	return nil
+}
+
+func (x *RetentionCohort) GetRates() []float64 {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type RetentionStatisticsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    int64  `protobuf:"varint,1,opt,name=start,proto3" form:"start" json:"start" query:"start"`            // 开始时间, 秒级时间戳, 统计这段时间内注册的用户
+	End      int64  `protobuf:"varint,2,opt,name=end,proto3" form:"end" json:"end" query:"end"`                    // 结束时间, 秒级时间戳
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" form:"timezone" json:"timezone" query:"timezone"` // 时区, 默认为Asia/Shanghai
+	Weeks    int64  `protobuf:"varint,4,opt,name=weeks,proto3" form:"weeks" json:"weeks" query:"weeks"`            // 统计注册后的周数, 默认为8, 最多26
+}
+
+func (x *RetentionStatisticsReq) Reset() {
+	*x = RetentionStatisticsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionStatisticsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionStatisticsReq) ProtoMessage() {}
+
+func (x *RetentionStatisticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionStatisticsReq.ProtoReflect.Descriptor instead.
+func (*RetentionStatisticsReq) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RetentionStatisticsReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *RetentionStatisticsReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *RetentionStatisticsReq) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RetentionStatisticsReq) GetWeeks() int64 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+type RetentionStatisticsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resp    *basic.Response    `protobuf:"bytes,1,opt,name=resp,proto3" form:"resp" json:"resp" query:"resp"`
+	Cohorts []*RetentionCohort `protobuf:"bytes,2,rep,name=cohorts,proto3" form:"cohorts" json:"cohorts" query:"cohorts"` // 按注册周正序
+}
+
+func (x *RetentionStatisticsResp) Reset() {
+	*x = RetentionStatisticsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionStatisticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionStatisticsResp) ProtoMessage() {}
+
+func (x *RetentionStatisticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionStatisticsResp.ProtoReflect.Descriptor instead.
+func (*RetentionStatisticsResp) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RetentionStatisticsResp) GetResp() *basic.Response {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *RetentionStatisticsResp) GetCohorts() []*RetentionCohort {
+	if x != nil {
+		return x.Cohorts
+	}
+	return nil
+}
+
+// 一个分组的消息量与反馈
+type MessageItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string  `protobuf:"bytes,1,opt,name=key,proto3" form:"key" json:"key" query:"key"`                                       // 分组的值, 按天分组时为当天0点的时间戳
+	Messages     int64   `protobuf:"varint,2,opt,name=messages,proto3" form:"messages" json:"messages" query:"messages"`                  // 模型回复的消息数
+	Likes        int64   `protobuf:"varint,3,opt,name=likes,proto3" form:"likes" json:"likes" query:"likes"`                              // 点赞数
+	Dislikes     int64   `protobuf:"varint,4,opt,name=dislikes,proto3" form:"dislikes" json:"dislikes" query:"dislikes"`                  // 点踩数
+	LikeRate     float64 `protobuf:"fixed64,5,opt,name=likeRate,proto3" form:"likeRate" json:"likeRate" query:"likeRate"`                 // 点赞数/消息数
+	DislikeRate  float64 `protobuf:"fixed64,6,opt,name=dislikeRate,proto3" form:"dislikeRate" json:"dislikeRate" query:"dislikeRate"`     // 点踩数/消息数
+	Satisfaction float64 `protobuf:"fixed64,7,opt,name=satisfaction,proto3" form:"satisfaction" json:"satisfaction" query:"satisfaction"` // 点赞数/(点赞数+点踩数)
+}
+
+func (x *MessageItem) Reset() {
+	*x = MessageItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageItem) ProtoMessage() {}
+
+func (x *MessageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageItem.ProtoReflect.Descriptor instead.
+func (*MessageItem) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MessageItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MessageItem) GetMessages() int64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *MessageItem) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *MessageItem) GetDislikes() int64 {
+	if x != nil {
+		return x.Dislikes
+	}
+	return 0
+}
+
+func (x *MessageItem) GetLikeRate() float64 {
+	if x != nil {
+		return x.LikeRate
+	}
+	return 0
+}
+
+func (x *MessageItem) GetDislikeRate() float64 {
+	if x != nil {
+		return x.DislikeRate
+	}
+	return 0
+}
+
+func (x *MessageItem) GetSatisfaction() float64 {
+	if x != nil {
+		return x.Satisfaction
+	}
+	return 0
+}
+
+type MessageStatisticsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    int64  `protobuf:"varint,1,opt,name=start,proto3" form:"start" json:"start" query:"start"`            // 开始时间, 秒级时间戳
+	End      int64  `protobuf:"varint,2,opt,name=end,proto3" form:"end" json:"end" query:"end"`                    // 结束时间, 秒级时间戳
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" form:"timezone" json:"timezone" query:"timezone"` // 时区, 默认为Asia/Shanghai
+	GroupBy  string `protobuf:"bytes,4,opt,name=groupBy,proto3" form:"groupBy" json:"groupBy" query:"groupBy"`     // 分组维度, day/model/bot, 默认为day
 }
 
-func (x *UserStatisticsResp) Reset() {
-	*x = UserStatisticsResp{}
+func (x *MessageStatisticsReq) Reset() {
+	*x = MessageStatisticsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_api_manage_proto_msgTypes[10]
+		mi := &file_core_api_manage_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserStatisticsResp) String() string {
+func (x *MessageStatisticsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserStatisticsResp) ProtoMessage() {}
+func (*MessageStatisticsReq) ProtoMessage() {}
 
-func (x *UserStatisticsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_api_manage_proto_msgTypes[10]
+func (x *MessageStatisticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,51 +1777,100 @@ func (x *UserStatisticsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserStatisticsResp.ProtoReflect.Descriptor instead.
-func (*UserStatisticsResp) Descriptor() ([]byte, []int) {
-	return file_core_api_manage_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MessageStatisticsReq.ProtoReflect.Descriptor instead.
+func (*MessageStatisticsReq) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UserStatisticsResp) GetResp() *basic.Response {
+func (x *MessageStatisticsReq) GetStart() int64 {
 	if x != nil {
-		return x.Resp
+		return x.Start
 	}
-	return nil
+	return 0
 }
 
-func (x *UserStatisticsResp) GetGrowth() []*UserStatisticsResp_Item {
+func (x *MessageStatisticsReq) GetEnd() int64 {
 	if x != nil {
-		return x.Growth
+		return x.End
 	}
-	return nil
+	return 0
 }
 
-func (x *UserStatisticsResp) GetAccumulate() []*UserStatisticsResp_Item {
+func (x *MessageStatisticsReq) GetTimezone() string {
 	if x != nil {
-		return x.Accumulate
+		return x.Timezone
 	}
-	return nil
+	return ""
 }
 
-func (x *UserStatisticsResp) GetTrend() *UserStatisticsResp_Trend {
+func (x *MessageStatisticsReq) GetGroupBy() string {
 	if x != nil {
-		return x.Trend
+		return x.GroupBy
+	}
+	return ""
+}
+
+type MessageStatisticsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resp  *basic.Response `protobuf:"bytes,1,opt,name=resp,proto3" form:"resp" json:"resp" query:"resp"`
+	Items []*MessageItem  `protobuf:"bytes,2,rep,name=items,proto3" form:"items" json:"items" query:"items"`
+	Total *MessageItem    `protobuf:"bytes,3,opt,name=total,proto3" form:"total" json:"total" query:"total"` // 统计周期内的合计
+}
+
+func (x *MessageStatisticsResp) Reset() {
+	*x = MessageStatisticsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_api_manage_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageStatisticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageStatisticsResp) ProtoMessage() {}
+
+func (x *MessageStatisticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_api_manage_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageStatisticsResp.ProtoReflect.Descriptor instead.
+func (*MessageStatisticsResp) Descriptor() ([]byte, []int) {
+	return file_core_api_manage_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MessageStatisticsResp) GetResp() *basic.Response {
+	if x != nil {
+		return x.Resp
 	}
 	return nil
 }
 
-func (x *UserStatisticsResp) GetTotalNewUsers() int64 {
+func (x *MessageStatisticsResp) GetItems() []*MessageItem {
 	if x != nil {
-		return x.TotalNewUsers
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *UserStatisticsResp) GetAverageDailyGrowth() float64 {
+func (x *MessageStatisticsResp) GetTotal() *MessageItem {
 	if x != nil {
-		return x.AverageDailyGrowth
+		return x.Total
 	}
-	return 0
+	return nil
 }
 
 type ListFeedBackResp_FeedBack struct {
@@ -795,7 +1889,7 @@ type ListFeedBackResp_FeedBack struct {
 func (x *ListFeedBackResp_FeedBack) Reset() {
 	*x = ListFeedBackResp_FeedBack{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_api_manage_proto_msgTypes[11]
+		mi := &file_core_api_manage_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -808,7 +1902,7 @@ func (x *ListFeedBackResp_FeedBack) String() string {
 func (*ListFeedBackResp_FeedBack) ProtoMessage() {}
 
 func (x *ListFeedBackResp_FeedBack) ProtoReflect() protoreflect.Message {
-	mi := &file_core_api_manage_proto_msgTypes[11]
+	mi := &file_core_api_manage_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1972,7 @@ type UserStatisticsResp_Item struct {
 func (x *UserStatisticsResp_Item) Reset() {
 	*x = UserStatisticsResp_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_api_manage_proto_msgTypes[12]
+		mi := &file_core_api_manage_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -891,7 +1985,7 @@ func (x *UserStatisticsResp_Item) String() string {
 func (*UserStatisticsResp_Item) ProtoMessage() {}
 
 func (x *UserStatisticsResp_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_core_api_manage_proto_msgTypes[12]
+	mi := &file_core_api_manage_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +2027,7 @@ type UserStatisticsResp_Trend struct {
 func (x *UserStatisticsResp_Trend) Reset() {
 	*x = UserStatisticsResp_Trend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_api_manage_proto_msgTypes[13]
+		mi := &file_core_api_manage_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -946,7 +2040,7 @@ func (x *UserStatisticsResp_Trend) String() string {
 func (*UserStatisticsResp_Trend) ProtoMessage() {}
 
 func (x *UserStatisticsResp_Trend) ProtoReflect() protoreflect.Message {
-	mi := &file_core_api_manage_proto_msgTypes[13]
+	mi := &file_core_api_manage_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,41 +2161,180 @@ var file_core_api_manage_proto_rawDesc = []byte{
 	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x57, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53,
 	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
 	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
 	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x65, 0x6e, 0x64, 0x22, 0x98, 0x03, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x72,
-	0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x61, 0x73, 0x69,
-	0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70,
-	0x12, 0x37, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x63, 0x63,
-	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a,
-	0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x72,
-	0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x61,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x22, 0x98, 0x03, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x77, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x61,
 	0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x05, 0x74, 0x72, 0x65,
-	0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72,
-	0x61, 0x67, 0x65, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x61, 0x69,
-	0x6c, 0x79, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x1a, 0x30, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x23, 0x0a, 0x05, 0x54, 0x72,
-	0x65, 0x6e, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
-	0x77, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x62, 0x42,
-	0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x68,
-	0x2d, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x69, 0x6e, 0x6e, 0x6f, 0x73, 0x70, 0x61,
-	0x72, 0x6b, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x7a, 0x2f,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x74, 0x6f, 0x2f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x24,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x47, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x1a, 0x30, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x23, 0x0a, 0x05, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12,
+	0x0c, 0x0a, 0x01, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a,
+	0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x62, 0x22, 0xad, 0x02, 0x0a, 0x09,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x63, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6f, 0x63, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x76, 0x67, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x61, 0x76, 0x67, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc8, 0x01, 0x0a, 0x12,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x12, 0x19, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x62, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x62,
+	0x6f, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x62, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x13, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23,
+	0x0a, 0x04, 0x72, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62,
+	0x61, 0x73, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x72,
+	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x56, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x99, 0x01,
+	0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x62, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x62, 0x6f, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x62, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x10, 0x54, 0x6f, 0x70,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a,
+	0x04, 0x72, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x61,
+	0x73, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x72, 0x65,
+	0x73, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x09, 0x44,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x59, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xea, 0x01,
+	0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x03, 0x64,
+	0x61, 0x75, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x03, 0x64, 0x61, 0x75,
+	0x12, 0x23, 0x0a, 0x03, 0x77, 0x61, 0x75, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x03, 0x77, 0x61, 0x75, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x61, 0x75, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x03, 0x6d, 0x61, 0x75, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x61, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x61, 0x75, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74,
+	0x69, 0x63, 0x6b, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x73, 0x74, 0x69, 0x63, 0x6b, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x6d, 0x0a, 0x0f, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x77, 0x65, 0x65,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x16, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x65, 0x65, 0x6b, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x22, 0x71, 0x0a,
+	0x17, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a,
+	0x07, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73,
+	0x22, 0xcf, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0x92, 0x01, 0x0a, 0x15, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x45, 0x5a,
+	0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x68, 0x2d, 0x70,
+	0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x69, 0x6e, 0x6e, 0x6f, 0x73, 0x70, 0x61, 0x72, 0x6b,
+	0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x74, 0x6f, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1116,7 +2349,7 @@ func file_core_api_manage_proto_rawDescGZIP() []byte {
 	return file_core_api_manage_proto_rawDescData
 }
 
-var file_core_api_manage_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_core_api_manage_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_core_api_manage_proto_goTypes = []interface{}{
 	(*AdminLoginReq)(nil),             // 0: manage.AdminLoginReq
 	(*AdminLoginResp)(nil),            // 1: manage.AdminLoginResp
@@ -1129,30 +2362,61 @@ var file_core_api_manage_proto_goTypes = []interface{}{
 	(*ListFeedBackResp)(nil),          // 8: manage.ListFeedBackResp
 	(*UserStatisticsReq)(nil),         // 9: manage.UserStatisticsReq
 	(*UserStatisticsResp)(nil),        // 10: manage.UserStatisticsResp
-	(*ListFeedBackResp_FeedBack)(nil), // 11: manage.ListFeedBackResp.FeedBack
-	(*UserStatisticsResp_Item)(nil),   // 12: manage.UserStatisticsResp.Item
-	(*UserStatisticsResp_Trend)(nil),  // 13: manage.UserStatisticsResp.Trend
-	(*basic.Response)(nil),            // 14: basic.Response
-	(*basic.Page)(nil),                // 15: basic.Page
+	(*UsageItem)(nil),                 // 11: manage.UsageItem
+	(*UsageStatisticsReq)(nil),        // 12: manage.UsageStatisticsReq
+	(*UsageStatisticsResp)(nil),       // 13: manage.UsageStatisticsResp
+	(*UserUsage)(nil),                 // 14: manage.UserUsage
+	(*TopUsageUserReq)(nil),           // 15: manage.TopUsageUserReq
+	(*TopUsageUserResp)(nil),          // 16: manage.TopUsageUserResp
+	(*DateCount)(nil),                 // 17: manage.DateCount
+	(*ActiveStatisticsReq)(nil),       // 18: manage.ActiveStatisticsReq
+	(*ActiveStatisticsResp)(nil),      // 19: manage.ActiveStatisticsResp
+	(*RetentionCohort)(nil),           // 20: manage.RetentionCohort
+	(*RetentionStatisticsReq)(nil),    // 21: manage.RetentionStatisticsReq
+	(*RetentionStatisticsResp)(nil),   // 22: manage.RetentionStatisticsResp
+	(*MessageItem)(nil),               // 23: manage.MessageItem
+	(*MessageStatisticsReq)(nil),      // 24: manage.MessageStatisticsReq
+	(*MessageStatisticsResp)(nil),     // 25: manage.MessageStatisticsResp
+	(*ListFeedBackResp_FeedBack)(nil), // 26: manage.ListFeedBackResp.FeedBack
+	(*UserStatisticsResp_Item)(nil),   // 27: manage.UserStatisticsResp.Item
+	(*UserStatisticsResp_Trend)(nil),  // 28: manage.UserStatisticsResp.Trend
+	(*basic.Response)(nil),            // 29: basic.Response
+	(*basic.Page)(nil),                // 30: basic.Page
 }
 var file_core_api_manage_proto_depIdxs = []int32{
-	14, // 0: manage.AdminLoginResp.resp:type_name -> basic.Response
-	15, // 1: manage.ListUserReq.page:type_name -> basic.Page
-	14, // 2: manage.ListUserResp.resp:type_name -> basic.Response
+	29, // 0: manage.AdminLoginResp.resp:type_name -> basic.Response
+	30, // 1: manage.ListUserReq.page:type_name -> basic.Page
+	29, // 2: manage.ListUserResp.resp:type_name -> basic.Response
 	2,  // 3: manage.ListUserResp.user:type_name -> manage.User
-	14, // 4: manage.ForbiddenUserResp.resp:type_name -> basic.Response
-	15, // 5: manage.ListFeedBackReq.page:type_name -> basic.Page
-	14, // 6: manage.ListFeedBackResp.resp:type_name -> basic.Response
-	11, // 7: manage.ListFeedBackResp.feedbacks:type_name -> manage.ListFeedBackResp.FeedBack
-	14, // 8: manage.UserStatisticsResp.resp:type_name -> basic.Response
-	12, // 9: manage.UserStatisticsResp.growth:type_name -> manage.UserStatisticsResp.Item
-	12, // 10: manage.UserStatisticsResp.accumulate:type_name -> manage.UserStatisticsResp.Item
-	13, // 11: manage.UserStatisticsResp.trend:type_name -> manage.UserStatisticsResp.Trend
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	29, // 4: manage.ForbiddenUserResp.resp:type_name -> basic.Response
+	30, // 5: manage.ListFeedBackReq.page:type_name -> basic.Page
+	29, // 6: manage.ListFeedBackResp.resp:type_name -> basic.Response
+	26, // 7: manage.ListFeedBackResp.feedbacks:type_name -> manage.ListFeedBackResp.FeedBack
+	29, // 8: manage.UserStatisticsResp.resp:type_name -> basic.Response
+	27, // 9: manage.UserStatisticsResp.growth:type_name -> manage.UserStatisticsResp.Item
+	27, // 10: manage.UserStatisticsResp.accumulate:type_name -> manage.UserStatisticsResp.Item
+	28, // 11: manage.UserStatisticsResp.trend:type_name -> manage.UserStatisticsResp.Trend
+	29, // 12: manage.UsageStatisticsResp.resp:type_name -> basic.Response
+	11, // 13: manage.UsageStatisticsResp.items:type_name -> manage.UsageItem
+	11, // 14: manage.UsageStatisticsResp.total:type_name -> manage.UsageItem
+	2,  // 15: manage.UserUsage.user:type_name -> manage.User
+	11, // 16: manage.UserUsage.usage:type_name -> manage.UsageItem
+	29, // 17: manage.TopUsageUserResp.resp:type_name -> basic.Response
+	14, // 18: manage.TopUsageUserResp.users:type_name -> manage.UserUsage
+	29, // 19: manage.ActiveStatisticsResp.resp:type_name -> basic.Response
+	17, // 20: manage.ActiveStatisticsResp.dau:type_name -> manage.DateCount
+	17, // 21: manage.ActiveStatisticsResp.wau:type_name -> manage.DateCount
+	17, // 22: manage.ActiveStatisticsResp.mau:type_name -> manage.DateCount
+	29, // 23: manage.RetentionStatisticsResp.resp:type_name -> basic.Response
+	20, // 24: manage.RetentionStatisticsResp.cohorts:type_name -> manage.RetentionCohort
+	29, // 25: manage.MessageStatisticsResp.resp:type_name -> basic.Response
+	23, // 26: manage.MessageStatisticsResp.items:type_name -> manage.MessageItem
+	23, // 27: manage.MessageStatisticsResp.total:type_name -> manage.MessageItem
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func file_core_api_manage_proto_init() {
@@ -1293,7 +2557,7 @@ func file_core_api_manage_proto_init() {
 			}
 		}
 		file_core_api_manage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFeedBackResp_FeedBack); i {
+			switch v := v.(*UsageItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1305,7 +2569,7 @@ func file_core_api_manage_proto_init() {
 			}
 		}
 		file_core_api_manage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserStatisticsResp_Item); i {
+			switch v := v.(*UsageStatisticsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1317,6 +2581,186 @@ func file_core_api_manage_proto_init() {
 			}
 		}
 		file_core_api_manage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageStatisticsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopUsageUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopUsageUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveStatisticsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveStatisticsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionCohort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionStatisticsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionStatisticsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageStatisticsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageStatisticsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeedBackResp_FeedBack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserStatisticsResp_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_api_manage_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserStatisticsResp_Trend); i {
 			case 0:
 				return &v.state
@@ -1331,13 +2775,15 @@ func file_core_api_manage_proto_init() {
 	}
 	file_core_api_manage_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	file_core_api_manage_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_core_api_manage_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_core_api_manage_proto_msgTypes[15].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_core_api_manage_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
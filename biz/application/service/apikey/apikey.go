@@ -0,0 +1,209 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
+	dapikey "github.com/xh-polaris/innospark-core-api/biz/domain/apikey"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/model"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/apikey"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var APIKeySVC *APIKeyService
+
+const (
+	maxKeys      = 20 // 每个用户未撤销的密钥数上限
+	maxNameRunes = 32 // 密钥名称的最大字符数
+)
+
+// APIKeyService 用户的API密钥, 只能在登录状态下管理
+type APIKeyService struct {
+	APIKeyMapper apikey.MongoMapper
+}
+
+// CreateAPIKey 创建密钥, 明文只在本次响应中返回
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, req *core_api.CreateAPIKeyReq) (*core_api.CreateAPIKeyResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	if err = dapikey.Manageable(ctx); err != nil {
+		return nil, err
+	}
+
+	name, err := checkName(req.GetName())
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.APIKeyCreateErrCode)
+	}
+	if err = checkModels(req.GetModels()); err != nil {
+		return nil, err
+	}
+	if req.GetQuota() < 0 {
+		return nil, errorx.New(errno.APIKeyCreateErrCode)
+	}
+	count, err := s.APIKeyMapper.CountKeys(ctx, uid)
+	if err != nil {
+		logs.Errorf("count api key error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.APIKeyCreateErrCode)
+	} else if count >= maxKeys {
+		return nil, errorx.New(errno.APIKeyLimitErrCode, errorx.KV("limit", strconv.Itoa(maxKeys)))
+	}
+
+	key, err := apikey.NewKey()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.APIKeyCreateErrCode)
+	}
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.APIKeyCreateErrCode)
+	}
+	k := &apikey.APIKey{
+		UserId:  oid,
+		Name:    name,
+		Hash:    apikey.HashKey(key),
+		Display: apikey.DisplayOf(key),
+		Models:  req.GetModels(),
+		Quota:   req.GetQuota(),
+	}
+	if err = s.APIKeyMapper.Insert(ctx, k); err != nil {
+		logs.Errorf("insert api key error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.APIKeyCreateErrCode)
+	}
+	return &core_api.CreateAPIKeyResp{Resp: util.Success(), Key: key, Info: keyInfo(k)}, nil
+}
+
+// ListAPIKey 分页查询当前用户的密钥
+func (s *APIKeyService) ListAPIKey(ctx context.Context, req *core_api.ListAPIKeyReq) (*core_api.ListAPIKeyResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	if err = dapikey.Manageable(ctx); err != nil {
+		return nil, err
+	}
+
+	keys, hasMore, err := s.APIKeyMapper.ListKeys(ctx, uid, req.GetPage())
+	if err != nil {
+		logs.Errorf("list api key error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.APIKeyListErrCode)
+	}
+	items := make([]*core_api.APIKeyInfo, len(keys))
+	for i, k := range keys {
+		items[i] = keyInfo(k)
+	}
+	resp := &core_api.ListAPIKeyResp{Resp: util.Success(), Keys: items, HasMore: hasMore}
+	if len(keys) > 0 {
+		resp.Cursor = keys[len(keys)-1].KeyId.Hex()
+	}
+	return resp, nil
+}
+
+// UpdateAPIKey 修改密钥的名称、模型范围或配额
+func (s *APIKeyService) UpdateAPIKey(ctx context.Context, req *core_api.UpdateAPIKeyReq) (*core_api.UpdateAPIKeyResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	if err = dapikey.Manageable(ctx); err != nil {
+		return nil, err
+	}
+
+	opt := &apikey.UpdateOption{Quota: req.Quota}
+	if req.Name != nil {
+		name, err := checkName(req.GetName())
+		if err != nil {
+			return nil, errorx.WrapByCode(err, errno.APIKeyUpdateErrCode)
+		}
+		opt.Name = &name
+	}
+	if req.GetResetModels() {
+		opt.Models = []string{}
+	} else if len(req.GetModels()) > 0 {
+		if err = checkModels(req.GetModels()); err != nil {
+			return nil, err
+		}
+		opt.Models = req.GetModels()
+	}
+	if req.GetQuota() < 0 {
+		return nil, errorx.New(errno.APIKeyUpdateErrCode)
+	}
+	if err = s.APIKeyMapper.Update(ctx, uid, req.GetKeyId(), opt); errors.Is(err, monc.ErrNotFound) {
+		return nil, errorx.New(errno.APIKeyNotFoundErrCode)
+	} else if err != nil {
+		logs.Errorf("update api key error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.APIKeyUpdateErrCode)
+	}
+	return &core_api.UpdateAPIKeyResp{Resp: util.Success()}, nil
+}
+
+// RevokeAPIKey 撤销密钥, 撤销后立即失效
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, req *core_api.RevokeAPIKeyReq) (*core_api.RevokeAPIKeyResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	if err = dapikey.Manageable(ctx); err != nil {
+		return nil, err
+	}
+
+	if err = s.APIKeyMapper.Revoke(ctx, uid, req.GetKeyId()); errors.Is(err, monc.ErrNotFound) {
+		return nil, errorx.New(errno.APIKeyNotFoundErrCode)
+	} else if err != nil {
+		logs.Errorf("revoke api key error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.APIKeyRevokeErrCode)
+	}
+	return &core_api.RevokeAPIKeyResp{Resp: util.Success()}, nil
+}
+
+func checkName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameRunes {
+		return "", errors.New("invalid api key name")
+	}
+	return name, nil
+}
+
+// checkModels 模型范围中只能包含已注册的模型
+func checkModels(models []string) error {
+	for _, m := range models {
+		if !model.Registered(m) {
+			return errorx.New(errno.GatewayModelNotFoundErrCode, errorx.KV("model", m))
+		}
+	}
+	return nil
+}
+
+func keyInfo(k *apikey.APIKey) *core_api.APIKeyInfo {
+	info := &core_api.APIKeyInfo{
+		KeyId:      k.KeyId.Hex(),
+		Name:       k.Name,
+		Display:    k.Display,
+		Models:     k.Models,
+		Quota:      k.Quota,
+		Used:       k.Used,
+		CreateTime: k.CreateTime.Unix(),
+	}
+	if !k.LastUsedTime.IsZero() {
+		info.LastUsedTime = k.LastUsedTime.Unix()
+	}
+	return info
+}
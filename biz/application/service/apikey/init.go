@@ -0,0 +1,11 @@
+package apikey
+
+import (
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/apikey"
+)
+
+func InitAPIKeySVC(apikey apikey.MongoMapper) {
+	APIKeySVC = &APIKeyService{
+		APIKeyMapper: apikey,
+	}
+}
@@ -1,21 +1,36 @@
 package base
 
 import (
+	"context"
+
+	apikeyapp "github.com/xh-polaris/innospark-core-api/biz/application/service/apikey"
 	"github.com/xh-polaris/innospark-core-api/biz/application/service/completions"
 	conversationapp "github.com/xh-polaris/innospark-core-api/biz/application/service/conversation"
+	exportapp "github.com/xh-polaris/innospark-core-api/biz/application/service/export"
 	feedbackapp "github.com/xh-polaris/innospark-core-api/biz/application/service/feedback"
+	gatewayapp "github.com/xh-polaris/innospark-core-api/biz/application/service/gateway"
 	"github.com/xh-polaris/innospark-core-api/biz/application/service/intelligence"
 	manageapp "github.com/xh-polaris/innospark-core-api/biz/application/service/manage"
+	memoryapp "github.com/xh-polaris/innospark-core-api/biz/application/service/memory"
+	shareapp "github.com/xh-polaris/innospark-core-api/biz/application/service/share"
 	"github.com/xh-polaris/innospark-core-api/biz/application/service/system"
+	trashapp "github.com/xh-polaris/innospark-core-api/biz/application/service/trash"
 	userapp "github.com/xh-polaris/innospark-core-api/biz/application/service/user"
 	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/memory"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/history"
+	tool "github.com/xh-polaris/innospark-core-api/biz/domain/tool"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cache/redis"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/apikey"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/feedback"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/folder"
+	umem "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/memory"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/share"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/user"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/storage"
 	"github.com/xh-polaris/innospark-core-api/pkg/ac"
@@ -28,6 +43,11 @@ type AppDependency struct {
 	UserMapper         user.MongoMapper
 	ConversationMapper conversation.MongoMapper
 	FeedbackMapper     feedback.MongoMapper
+	MemoryMapper       umem.MongoMapper
+	ShareMapper        share.MongoMapper
+	FolderMapper       folder.MongoMapper
+	APIKeyMapper       apikey.MongoMapper
+	UsageMapper        usage.MongoMapper
 
 	His    *history.HistoryManager
 	Memory *memory.MemoryManager
@@ -40,6 +60,11 @@ func InitInfra(deps *AppDependency) {
 	deps.UserMapper = user.NewUserMongoMapper(conf.GetConfig())
 	deps.ConversationMapper = conversation.NewConversationMongoMapper(conf.GetConfig())
 	deps.FeedbackMapper = feedback.NewFeedbackMongoMapper(conf.GetConfig())
+	deps.MemoryMapper = umem.NewMemoryMongoMapper(conf.GetConfig())
+	deps.ShareMapper = share.NewShareMongoMapper(conf.GetConfig())
+	deps.FolderMapper = folder.NewFolderMongoMapper(conf.GetConfig())
+	deps.APIKeyMapper = apikey.NewAPIKeyMongoMapper(conf.GetConfig())
+	deps.UsageMapper = usage.NewUsageMongoMapper(conf.GetConfig())
 	if err := ac.InitAc(conf.GetConfig().Sensitive.Sensitive); err != nil {
 		panic(err)
 	}
@@ -51,17 +76,31 @@ func InitApp() {
 	InitComponent(deps)
 	InitService(deps)
 }
+
+// StartJobs 启动后台定时任务, ctx结束时停止
+func StartJobs(ctx context.Context) {
+	go trashapp.TrashSVC.PurgeLoop(ctx)
+}
+
 func InitComponent(deps *AppDependency) {
 	deps.His = history.New(deps.Cache, deps.MessageMapper)
-	deps.Memory = memory.New(deps.His)
+	deps.Memory = memory.New(deps.His, deps.ConversationMapper, deps.MemoryMapper, deps.UsageMapper)
+	tool.InitSearchCache(deps.Cache)
+	limit.Init(deps.Cache)
 }
 
 func InitService(deps *AppDependency) {
-	completions.InitCompletionsSVC(deps.Memory)
-	conversationapp.InitConversationSVC(deps.ConversationMapper, deps.MessageMapper)
+	apikeyapp.InitAPIKeySVC(deps.APIKeyMapper)
+	completions.InitCompletionsSVC(deps.Memory, deps.UsageMapper)
+	conversationapp.InitConversationSVC(deps.ConversationMapper, deps.MessageMapper, deps.FolderMapper, deps.His)
+	exportapp.InitExportSVC(deps.ConversationMapper, deps.MessageMapper, deps.Cache, deps.COS, deps.UserMapper)
 	feedbackapp.InitFeedbackSVC(deps.MessageMapper, deps.FeedbackMapper, deps.His)
+	gatewayapp.InitGatewaySVC(deps.UserMapper, deps.UsageMapper)
 	userapp.InitUserSVC(deps.UserMapper)
 	intelligence.InitIntelligenceSVC()
-	manageapp.InitManageSVC(deps.UserMapper, deps.FeedbackMapper)
+	manageapp.InitManageSVC(deps.UserMapper, deps.FeedbackMapper, deps.MessageMapper, deps.UsageMapper)
+	memoryapp.InitMemorySVC(deps.MemoryMapper)
+	shareapp.InitShareSVC(deps.ShareMapper, deps.ConversationMapper, deps.MessageMapper, deps.His, deps.Cache)
 	system.InitAttachSVC(deps.COS, deps.UserMapper)
+	trashapp.InitTrashSVC(deps.ConversationMapper, deps.MessageMapper, deps.FeedbackMapper, deps.ShareMapper, deps.His, deps.COS, deps.Cache)
 }
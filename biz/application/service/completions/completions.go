@@ -5,16 +5,19 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cloudwego/eino/schema"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
 	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/apikey"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/flow"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/memory"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/user"
-	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
 	"github.com/xh-polaris/innospark-core-api/pkg/ac"
 	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
 	"github.com/xh-polaris/innospark-core-api/pkg/logs"
@@ -27,6 +30,7 @@ type CompletionsService struct {
 	Memory             *memory.MemoryManager
 	UserMapper         user.MongoMapper
 	ConversationMapper conversation.MongoMapper
+	UsageMapper        usage.MongoMapper
 }
 
 func (s *CompletionsService) Completions(c *app.RequestContext, ctx context.Context, req *core_api.CompletionsReq) error {
@@ -50,6 +54,11 @@ func (s *CompletionsService) Completions(c *app.RequestContext, ctx context.Cont
 		return errorx.New(errno.ErrForbidden, errorx.KV("time", expire.Local().Format(time.RFC3339)))
 	}
 
+	// 使用API密钥时校验模型范围与配额
+	if err = apikey.Check(ctx, req.Model); err != nil {
+		return err
+	}
+
 	// 暂时只支持一个新增对话
 	if len(req.Messages) > 1 {
 		return errorx.New(errno.UnImplementErrCode)
@@ -64,12 +73,72 @@ func (s *CompletionsService) Completions(c *app.RequestContext, ctx context.Cont
 		return errorx.New(errno.ErrSensitive, errorx.KV("text", strings.Join(hits, ",")))
 	}
 
-	// 构建对话状态
-	oids, err := util.ObjectIDsFromHex(req.ConversationId)
+	// 限流与token配额
+	ticket, err := limit.Acquire(ctx, c, uid, u.Tier, req.Model)
 	if err != nil {
 		return err
 	}
-	st := state.NewState(c, req, u, oids[0], oids[0])
+	// 无论对话是否成功都计入已产生的用量, 中途失败或断开时按已输出的内容计入
+	var st *state.RelayContext
+	defer func() { s.account(ctx, ticket, st) }()
 
-	return flow.DoCompletions(ctx, st, s.Memory, s.ConversationMapper)
+	// 获取当前段落
+	conv, err := s.ConversationMapper.GetConversation(ctx, req.ConversationId)
+	if err != nil {
+		return errorx.WrapByCode(err, errno.CompletionsErrCode)
+	}
+	section := conv.CurrentSection()
+
+	// 构建对话状态
+	st = state.NewState(c, req, u, conv.ConversationId, section.SectionId)
+	st.Info.SectionStart = section.StartIndex
+
+	return flow.DoCompletions(ctx, st, ticket, s.Memory, s.ConversationMapper)
+}
+
+// account 将本轮对话的用量计入用户配额与API密钥, 并记录用量流水; 配额已在对话结束时计入的不会重复计入
+func (s *CompletionsService) account(ctx context.Context, ticket *limit.Ticket, st *state.RelayContext) {
+	if st == nil { // 没有开始对话
+		return
+	}
+	tu, estimated := st.Info.Usage()
+	if tu.TotalTokens <= 0 {
+		return
+	}
+	ticket.Consume(ctx, int64(tu.TotalTokens))
+	apikey.Charge(ctx, int64(tu.TotalTokens))
+	s.recordUsage(ctx, st, tu, estimated)
+}
+
+// recordUsage 记录本轮对话的用量流水
+func (s *CompletionsService) recordUsage(ctx context.Context, st *state.RelayContext, u *schema.TokenUsage, estimated bool) {
+	info := st.Info
+	r := &usage.Record{
+		UserId:           info.UserId,
+		ConversationId:   info.ConversationId,
+		Source:           usage.SourceCompletions,
+		Model:            info.ModelInfo.Model,
+		BotId:            info.ModelInfo.BotId,
+		PromptTokens:     int64(u.PromptTokens),
+		CompletionTokens: int64(u.CompletionTokens),
+		CachedTokens:     int64(u.PromptTokenDetails.CachedTokens),
+		TotalTokens:      int64(u.TotalTokens),
+		Estimated:        estimated,
+		OCRCalls:         int64(info.ModelInfo.OCRCalls),
+		Latency:          time.Since(info.StartTime).Milliseconds(),
+	}
+	if am := info.MessageInfo.AssistantMessage; am != nil {
+		r.MessageId = am.MessageId
+	}
+	if si := info.SearchInfo; si != nil {
+		si.Lock()
+		r.SearchCalls = int64(si.Calls)
+		si.Unlock()
+	}
+	if k := adaptor.ExtractAPIKey(ctx); k != nil {
+		r.KeyId = k.KeyId
+	}
+	if err := s.UsageMapper.Insert(context.WithoutCancel(ctx), r); err != nil {
+		logs.CtxErrorf(ctx, "[completions] record usage err: %s", errorx.ErrorWithoutStack(err))
+	}
 }
@@ -4,13 +4,15 @@ import (
 	"github.com/xh-polaris/innospark-core-api/biz/conf"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/memory"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/user"
 )
 
-func InitCompletionsSVC(memory *memory.MemoryManager) {
+func InitCompletionsSVC(memory *memory.MemoryManager, usage usage.MongoMapper) {
 	CompletionsSVC = &CompletionsService{
 		Memory:             memory,
 		UserMapper:         user.NewUserMongoMapper(conf.GetConfig()),
 		ConversationMapper: conversation.NewConversationMongoMapper(conf.GetConfig()),
+		UsageMapper:        usage,
 	}
 }
@@ -2,31 +2,46 @@ package conversation
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"regexp"
-	"strings"
+	"sort"
+	"time"
 
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
 
-	"github.com/cloudwego/eino-ext/components/model/openai"
 	"github.com/cloudwego/eino/schema"
 	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/basic"
 	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
 	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/flow"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/history"
 	dm "github.com/xh-polaris/innospark-core-api/biz/domain/message"
+	dmodel "github.com/xh-polaris/innospark-core-api/biz/domain/model"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/folder"
 	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
 	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
 	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/pkg/segment"
 	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 var ConversationSVC *ConversationService
 
+const (
+	forkBriefSuffix = " (分支)" // 分叉出的对话的标题后缀
+	snippetWidth    = 80      // 消息检索结果的摘要长度
+)
+
 type ConversationService struct {
 	ConversationMapper conversation.MongoMapper
 	MessageMapper      mmsg.MongoMapper
+	FolderMapper       folder.MongoMapper
+	His                *history.HistoryManager
 }
 
 func (s *ConversationService) CreateConversation(ctx context.Context, req *core_api.CreateConversationReq) (*core_api.CreateConversationResp, error) {
@@ -48,6 +63,7 @@ func (s *ConversationService) CreateConversation(ctx context.Context, req *core_
 	return &core_api.CreateConversationResp{Resp: util.Success(), ConversationId: newConversation.ConversationId.Hex()}, nil
 }
 
+// GenerateBrief 根据首条消息生成对话标题, 用户重命名过的对话直接返回当前标题
 func (s *ConversationService) GenerateBrief(ctx context.Context, req *core_api.GenerateBriefReq) (*core_api.GenerateBriefResp, error) {
 	// 鉴权
 	uid, err := adaptor.ExtractUserId(ctx)
@@ -55,18 +71,18 @@ func (s *ConversationService) GenerateBrief(ctx context.Context, req *core_api.G
 		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
 		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
 	}
+	conv, err := s.ConversationMapper.GetConversation(ctx, req.ConversationId)
+	if err == nil && conv.UserId.Hex() != uid {
+		err = monc.ErrNotFound
+	}
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ConversationGenerateBriefErrCode)
+	}
+	if conv.Renamed {
+		return &core_api.GenerateBriefResp{Resp: util.Success(), Brief: conv.Brief}, nil
+	}
 	// 生成标题
-	m, err := openai.NewChatModel(context.Background(), &openai.ChatModelConfig{
-		BaseURL: conf.GetConfig().InnoSpark.DefaultBaseURL,
-		APIKey:  conf.GetConfig().InnoSpark.DefaultAPIKey,
-		Model:   "InnoSpark",
-	})
-	//m, err := ark.NewChatModel(ctx, &ark.ChatModelConfig{
-	//	BaseURL: "https://ark.cn-beijing.volces.com/api/v3",
-	//	Region:  "cn-beijing",
-	//	APIKey:  conf.GetConfig().ARK.APIKey,
-	//	Model:   "doubao-1-5-pro-32k-250115",
-	//})
+	m, err := dmodel.GetModel(ctx, flow.TitleModel(), uid, "")
 	if err != nil {
 		return nil, errorx.WrapByCode(err, errno.ConversationGenerateBriefErrCode)
 	}
@@ -75,14 +91,15 @@ func (s *ConversationService) GenerateBrief(ctx context.Context, req *core_api.G
 	if err != nil {
 		return nil, errorx.WrapByCode(err, errno.ConversationGenerateBriefErrCode)
 	}
-	out.Content = strings.Trim(out.Content, "\"")
-	re := regexp.MustCompile(`[\[(（][^]）)]*[]）)]`)
-	out.Content = re.ReplaceAllString(out.Content, "")
-	// 更新标题
-	if err = s.ConversationMapper.UpdateConversationBrief(ctx, uid, req.ConversationId, out.Content); err != nil {
+	brief := flow.CleanTitle(out.Content)
+	// 更新标题, 期间被用户重命名时不覆盖
+	ok, err := s.ConversationMapper.UpdateConversationTitle(ctx, req.ConversationId, brief, primitive.NilObjectID)
+	if err != nil {
 		return nil, errorx.WrapByCode(err, errno.ConversationGenerateBriefErrCode)
+	} else if !ok || brief == "" {
+		brief = conv.Brief
 	}
-	return &core_api.GenerateBriefResp{Resp: util.Success(), Brief: out.Content}, nil
+	return &core_api.GenerateBriefResp{Resp: util.Success(), Brief: brief}, nil
 }
 
 func (s *ConversationService) RenameConversation(ctx context.Context, req *core_api.RenameConversationReq) (*core_api.RenameConversationResp, error) {
@@ -111,8 +128,23 @@ func (s *ConversationService) ListConversation(ctx context.Context, req *core_ap
 		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
 	}
 
+	// 筛选与排序条件
+	opt := &conversation.ListOption{Tag: req.GetTag(), Pinned: req.Pinned, PinnedFirst: req.GetPinnedFirst()}
+	if req.FolderId != nil {
+		var fid primitive.ObjectID
+		if req.GetFolderId() != "" {
+			if fid, err = primitive.ObjectIDFromHex(req.GetFolderId()); err != nil {
+				return nil, errorx.WrapByCode(err, errno.ConversationListErrCode)
+			}
+		}
+		opt.FolderId = &fid
+	}
+	if req.GetSortBy() == "updateTime" {
+		opt.SortBy = cst.UpdateTime
+	}
+
 	// 分页获取Conversation列表，并转化为ListConversationResp_ConversationItem
-	conversations, hasMore, err := s.ConversationMapper.ListConversations(ctx, uid, req.GetPage())
+	conversations, hasMore, err := s.ConversationMapper.ListConversations(ctx, uid, opt, req.GetPage())
 	if err != nil {
 		logs.Errorf("list conversation error: %s", errorx.ErrorWithoutStack(err))
 		return nil, errorx.WrapByCode(err, errno.ConversationListErrCode)
@@ -126,12 +158,17 @@ func (s *ConversationService) ListConversation(ctx context.Context, req *core_ap
 			CreateTime:     conv.CreateTime.Unix(),
 			UpdateTime:     conv.UpdateTime.Unix(),
 			Ext:            conv.Ext,
+			Pinned:         conv.Pinned,
+			Tags:           conv.Tags,
+		}
+		if !conv.FolderId.IsZero() {
+			items[i].FolderId = conv.FolderId.Hex()
 		}
 	}
 
 	resp := &core_api.ListConversationResp{Resp: util.Success(), Conversations: items, HasMore: hasMore}
 	if len(conversations) > 0 {
-		resp.Cursor = conversations[len(conversations)-1].ConversationId.Hex()
+		resp.Cursor = conversation.EncodeCursor(conversations[len(conversations)-1])
 	}
 	// 返回响应
 	return resp, nil
@@ -145,46 +182,277 @@ func (s *ConversationService) GetConversation(ctx context.Context, req *core_api
 		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
 	}
 
-	msgs, hasMore, err := s.MessageMapper.ListMessage(ctx, req.GetConversationId(), req.GetPage())
+	conv, err := s.ConversationMapper.GetConversation(ctx, req.GetConversationId())
 	if err != nil {
-		logs.Errorf("get conversation messages error: %s", errorx.ErrorWithoutStack(err))
+		logs.Errorf("get conversation error: %s", errorx.ErrorWithoutStack(err))
 		return nil, errorx.WrapByCode(err, errno.ConversationGetErrCode)
 	}
-	// 判断最后一条消息是否是regen的模型消息
-	var regen []*mmsg.Message
-	if len(msgs) > 0 && msgs[0].Role == cst.AssistantEnum {
-		replyId := msgs[0].ReplyId.Hex()
-		for _, msg := range msgs[1:] {
-			if msg.ReplyId.Hex() == replyId {
-				if regen == nil {
-					regen = []*mmsg.Message{msgs[0]}
-				}
-				regen = append(regen, msg)
-			}
-		}
+	// 只取出分支结构还原各段落当前激活的路径, 分页后再取出当页消息的完整内容
+	branches, err := s.MessageMapper.RetrieveBranches(ctx, conv.ConversationId)
+	if err != nil {
+		logs.Errorf("get conversation branches error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationGetErrCode)
 	}
+	tree := mmsg.NewTree(branches)
+	msgs, hasMore := util.SplitAndHasMore(pageAfter(tree.ActivePath(), req.GetPage()), req.GetPage())
+	regen := regenList(tree, msgs)
+	full, err := s.MessageMapper.FindMany(ctx, append(messageIds(msgs), messageIds(regen)...))
+	if err != nil {
+		logs.Errorf("get conversation messages error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationGetErrCode)
+	}
+	msgs, regen = withContent(msgs, full), withContent(regen, full)
 	resp := &core_api.GetConversationResp{
 		Resp:        util.Success(),
 		MessageList: dm.MMsgToFMsgList(msgs),
 		RegenList:   dm.MMsgToFMsgList(regen),
 		HasMore:     hasMore,
+		SectionId:   conv.CurrentSection().SectionId.Hex(),
+	}
+	for i, msg := range msgs {
+		resp.MessageList[i].BranchCount = int32(len(tree.Siblings(msg.MessageId)))
 	}
 	if len(resp.MessageList) > 0 {
 		resp.Cursor = msgs[len(msgs)-1].MessageId.Hex()
 	}
+	// 段落边界
+	for _, section := range conv.SectionList() {
+		resp.Sections = append(resp.Sections, &core_api.Section{
+			SectionId:  section.SectionId.Hex(),
+			StartIndex: section.StartIndex,
+			CreateTime: section.CreateTime.Unix(),
+		})
+	}
 	return resp, nil
 }
 
+// pageAfter 取出游标之前的消息, 游标为上一页最后一条消息的id, msgs按索引倒序
+func pageAfter(msgs []*mmsg.Message, page *basic.Page) []*mmsg.Message {
+	if page == nil || page.Cursor == nil {
+		return msgs
+	}
+	cursor, err := primitive.ObjectIDFromHex(*page.Cursor)
+	if err != nil {
+		return nil
+	}
+	var after []*mmsg.Message
+	for _, msg := range msgs {
+		if msg.MessageId.Hex() < cursor.Hex() {
+			after = append(after, msg)
+		}
+	}
+	return after
+}
+
+// messageIds 消息的id列表
+func messageIds(msgs []*mmsg.Message) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, len(msgs))
+	for i, msg := range msgs {
+		ids[i] = msg.MessageId
+	}
+	return ids
+}
+
+// withContent 将只有分支结构的消息替换为完整的消息, 保持原有顺序, 取不到的消息被跳过
+func withContent(msgs, full []*mmsg.Message) []*mmsg.Message {
+	byId := make(map[primitive.ObjectID]*mmsg.Message, len(full))
+	for _, msg := range full {
+		byId[msg.MessageId] = msg
+	}
+	out := make([]*mmsg.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		if m, ok := byId[msg.MessageId]; ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// regenList 最新的模型消息存在重新生成的结果时, 返回包括自身在内的所有结果
+func regenList(tree *mmsg.Tree, msgs []*mmsg.Message) []*mmsg.Message {
+	if len(msgs) == 0 || msgs[0].Role != cst.AssistantEnum {
+		return nil
+	}
+	latest, regen := msgs[0], []*mmsg.Message{msgs[0]}
+	for _, msg := range tree.Siblings(latest.MessageId) { // 同一用户消息下的其他分支
+		if msg != latest {
+			regen = append(regen, msg)
+		}
+	}
+	for _, msg := range msgs[1:] { // 旧版本消息没有分支, 重新生成的结果依次排列
+		if msg.ReplyId == latest.ReplyId {
+			regen = append(regen, msg)
+		}
+	}
+	if len(regen) == 1 {
+		return nil
+	}
+	return regen
+}
+
+func (s *ConversationService) ListBranch(ctx context.Context, req *core_api.ListBranchReq) (*core_api.ListBranchResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	msg, err := s.MessageMapper.FindOne(ctx, req.GetMessageId())
+	if err != nil || msg.UserId.Hex() != uid {
+		logs.Errorf("find message error: %v", err)
+		return nil, errorx.New(errno.ConversationBranchNotFoundErrCode)
+	}
+	siblings, err := s.His.ListBranch(ctx, msg)
+	if err != nil {
+		logs.Errorf("list branch error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationListBranchErrCode)
+	}
+	messages := dm.MMsgToFMsgList(siblings)
+	for _, m := range messages {
+		m.BranchCount = int32(len(messages))
+	}
+	return &core_api.ListBranchResp{Resp: util.Success(), Messages: messages}, nil
+}
+
+func (s *ConversationService) SwitchBranch(ctx context.Context, req *core_api.SwitchBranchReq) (*core_api.SwitchBranchResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	msg, err := s.MessageMapper.FindOne(ctx, req.GetMessageId())
+	if err != nil || msg.UserId.Hex() != uid {
+		logs.Errorf("find message error: %v", err)
+		return nil, errorx.New(errno.ConversationBranchNotFoundErrCode)
+	}
+	if err = s.His.SwitchBranch(ctx, msg); err != nil {
+		logs.Errorf("switch branch error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationSwitchBranchErrCode)
+	}
+	return &core_api.SwitchBranchResp{Resp: util.Success()}, nil
+}
+
+func (s *ConversationService) ForkConversation(ctx context.Context, req *core_api.ForkConversationReq) (*core_api.ForkConversationResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	ori, err := s.ConversationMapper.GetConversation(ctx, req.GetConversationId())
+	if err != nil || ori.UserId.Hex() != uid || ori.Status == cst.DeletedStatus {
+		logs.Errorf("get conversation error: %v", err)
+		return nil, errorx.New(errno.ConversationForkErrCode)
+	}
+	branches, err := s.MessageMapper.RetrieveBranches(ctx, ori.ConversationId)
+	if err != nil {
+		logs.Errorf("get conversation branches error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationForkErrCode)
+	}
+	// 激活路径上从所选消息开始的更早部分, 即分叉后保留的历史记录, 只取出这部分消息的完整内容
+	path := mmsg.NewTree(branches).ActivePath()
+	for len(path) > 0 && path[0].MessageId.Hex() != req.GetMessageId() {
+		path = path[1:]
+	}
+	if len(path) == 0 {
+		return nil, errorx.New(errno.ConversationForkErrCode)
+	}
+	full, err := s.MessageMapper.FindMany(ctx, messageIds(path))
+	if err != nil {
+		logs.Errorf("get conversation messages error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationForkErrCode)
+	}
+	path = withContent(path, full)
+
+	now, cid := time.Now(), primitive.NewObjectID()
+	msgs, sections := dm.ForkMMsgs(path, cid)
+	c := &conversation.Conversation{
+		ConversationId: cid,
+		UserId:         ori.UserId,
+		Brief:          ori.Brief + forkBriefSuffix,
+		BotId:          ori.BotId,
+		VL:             ori.VL,
+		Ext:            ori.Ext,
+		CreateTime:     now,
+		UpdateTime:     now,
+		Sections:       sections,
+	}
+	// 先写入消息再创建对话, 避免出现没有消息的对话
+	if err = s.MessageMapper.InsertMany(ctx, msgs); err != nil {
+		logs.Errorf("insert fork messages error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationForkErrCode)
+	}
+	if err = s.ConversationMapper.InsertConversation(ctx, c); err != nil {
+		logs.Errorf("insert fork conversation error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationForkErrCode)
+	}
+	// 重建当前段落的历史记录缓存
+	var current []*mmsg.Message
+	for _, msg := range msgs {
+		if msg.SectionId == c.CurrentSection().SectionId {
+			current = append(current, msg)
+		}
+	}
+	if err = s.His.CacheMessages(ctx, cid.Hex(), current, true); err != nil {
+		logs.Errorf("cache fork messages error: %s", errorx.ErrorWithoutStack(err))
+	}
+	return &core_api.ForkConversationResp{Resp: util.Success(), ConversationId: cid.Hex(), Brief: c.Brief}, nil
+}
+
+func (s *ConversationService) NewSection(ctx context.Context, req *core_api.NewSectionReq) (*core_api.NewSectionResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	// 新段落从最新一条消息之后开始
+	var start int32
+	msgs, err := s.MessageMapper.RetrieveMessages(ctx, req.GetConversationId(), "", 1)
+	if err != nil {
+		logs.Errorf("retrieve latest message error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationNewSectionErrCode)
+	}
+	if len(msgs) > 0 {
+		start = msgs[0].Index + 1
+	}
+	section, err := s.ConversationMapper.NewSection(ctx, uid, req.GetConversationId(), start)
+	if err != nil {
+		logs.Errorf("new section error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationNewSectionErrCode)
+	}
+	return &core_api.NewSectionResp{Resp: util.Success(), Section: &core_api.Section{
+		SectionId:  section.SectionId.Hex(),
+		StartIndex: section.StartIndex,
+		CreateTime: section.CreateTime.Unix(),
+	}}, nil
+}
+
 func (s *ConversationService) DeleteConversation(ctx context.Context, req *core_api.DeleteConversationReq) (*core_api.DeleteConversationResp, error) {
 	uid, err := adaptor.ExtractUserId(ctx)
 	if err != nil {
 		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
 		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
 	}
-	if err = s.ConversationMapper.DeleteConversation(ctx, uid, req.ConversationId); err != nil {
+	if err = s.ConversationMapper.DeleteConversation(ctx, uid, req.ConversationId); errors.Is(err, monc.ErrNotFound) {
+		return &core_api.DeleteConversationResp{Resp: util.Success()}, nil // 不存在或已在回收站中
+	} else if err != nil {
 		logs.Errorf("delete conversation error: %s", errorx.ErrorWithoutStack(err))
 		return nil, errorx.WrapByCode(err, errno.ConversationDeleteErrCode)
 	}
+	// 对话移入回收站, 其中的消息一并删除, 恢复时还原
+	if err = s.MessageMapper.TrashByConversation(ctx, req.ConversationId); err != nil {
+		logs.Errorf("trash messages error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationDeleteErrCode)
+	}
+	if err = s.His.Clear(ctx, req.ConversationId); err != nil {
+		logs.Errorf("clear history cache error: %s", errorx.ErrorWithoutStack(err))
+	}
 	return &core_api.DeleteConversationResp{Resp: util.Success()}, nil
 }
 
@@ -209,6 +477,11 @@ func (s *ConversationService) SearchConversation(ctx context.Context, req *core_
 			Brief:          conv.Brief,
 			CreateTime:     conv.CreateTime.Unix(),
 			UpdateTime:     conv.UpdateTime.Unix(),
+			Pinned:         conv.Pinned,
+			Tags:           conv.Tags,
+		}
+		if !conv.FolderId.IsZero() {
+			items[i].FolderId = conv.FolderId.Hex()
 		}
 	}
 
@@ -220,6 +493,74 @@ func (s *ConversationService) SearchConversation(ctx context.Context, req *core_
 	return resp, nil
 }
 
+// SearchMessage 全文检索用户的消息, 返回命中位置附近的摘要与高亮, 以便跳转到对应消息
+func (s *ConversationService) SearchMessage(ctx context.Context, req *core_api.SearchMessageReq) (*core_api.SearchMessageResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	terms := segment.QueryTerms(req.GetKey())
+	if len(terms) == 0 { // 关键词中没有可检索的内容
+		return &core_api.SearchMessageResp{Resp: util.Success()}, nil
+	}
+	msgs, hasMore, err := s.MessageMapper.SearchMessages(ctx, uid, terms, req.GetIncludeThink(), req.GetPage())
+	if err != nil {
+		logs.Errorf("search message error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationSearchErrCode)
+	}
+
+	words, briefs := segment.Words(req.GetKey()), map[primitive.ObjectID]string{}
+	hits := make([]*core_api.MessageHit, len(msgs))
+	for i, msg := range msgs {
+		brief, ok := briefs[msg.ConversationId]
+		if !ok {
+			if conv, err := s.ConversationMapper.GetConversation(ctx, msg.ConversationId.Hex()); err == nil {
+				brief = conv.Brief
+			}
+			briefs[msg.ConversationId] = brief
+		}
+		// 优先展示正文中的命中, 正文未命中时展示深度思考内容
+		field, text := "content", msg.SearchText()
+		msg.IndexTerms()
+		if req.GetIncludeThink() && !containsAll(msg.Terms, terms) {
+			field, text = "think", msg.Ext.Think
+		}
+		snippet, highlights := segment.Snippet(text, words, snippetWidth)
+		hits[i] = &core_api.MessageHit{
+			ConversationId: msg.ConversationId.Hex(),
+			Brief:          brief,
+			SectionId:      msg.SectionId.Hex(),
+			MessageId:      msg.MessageId.Hex(),
+			Index:          msg.Index,
+			Role:           mmsg.RoleItoS[msg.Role],
+			Field:          field,
+			Snippet:        snippet,
+			CreateTime:     msg.CreateTime.Unix(),
+		}
+		for _, h := range highlights {
+			hits[i].Highlights = append(hits[i].Highlights, &core_api.Highlight{Start: int32(h.Start), Length: int32(h.Length)})
+		}
+	}
+	resp := &core_api.SearchMessageResp{Resp: util.Success(), Hits: hits, HasMore: hasMore}
+	if len(msgs) > 0 {
+		resp.Cursor = msgs[len(msgs)-1].MessageId.Hex()
+	}
+	return resp, nil
+}
+
+// containsAll 有序的词项中是否包含全部检索词项
+func containsAll(sorted, terms []string) bool {
+	for _, t := range terms {
+		if i := sort.SearchStrings(sorted, t); i == len(sorted) || sorted[i] != t {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *ConversationService) GetConversationExt(ctx context.Context, c *core_api.GetConversationExtReq) (*core_api.GetConversationExtResp, error) {
 	// 鉴权
 	_, err := adaptor.ExtractUserId(ctx)
@@ -0,0 +1,234 @@
+package conversation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/folder"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/* 对话的整理, 包括置顶、文件夹与标签 */
+
+const (
+	maxFolders    = 50 // 每个用户的文件夹数上限
+	maxNameLength = 20 // 文件夹名称与标签的字符数上限
+	maxTags       = 10 // 每个对话的标签数上限
+)
+
+// PinConversation 置顶或取消置顶对话
+func (s *ConversationService) PinConversation(ctx context.Context, req *core_api.PinConversationReq) (*core_api.PinConversationResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	if err = s.ConversationMapper.UpdateConversationPin(ctx, uid, req.GetConversationId(), req.GetPinned()); err != nil {
+		logs.Errorf("pin conversation error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationPinErrCode)
+	}
+	return &core_api.PinConversationResp{Resp: util.Success()}, nil
+}
+
+// MoveConversation 将对话移入文件夹, 文件夹id为空时移出文件夹
+func (s *ConversationService) MoveConversation(ctx context.Context, req *core_api.MoveConversationReq) (*core_api.MoveConversationResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	var fid primitive.ObjectID
+	if req.GetFolderId() != "" {
+		f, err := s.FolderMapper.GetFolder(ctx, uid, req.GetFolderId())
+		if err != nil {
+			logs.Errorf("get folder error: %s", errorx.ErrorWithoutStack(err))
+			return nil, errorx.New(errno.FolderNotFoundErrCode)
+		}
+		fid = f.FolderId
+	}
+	if err = s.ConversationMapper.UpdateConversationFolder(ctx, uid, req.GetConversationId(), fid); err != nil {
+		logs.Errorf("move conversation error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationMoveErrCode)
+	}
+	return &core_api.MoveConversationResp{Resp: util.Success()}, nil
+}
+
+// TagConversation 设置对话的标签, 标签去除首尾空白并去重
+func (s *ConversationService) TagConversation(ctx context.Context, req *core_api.TagConversationReq) (*core_api.TagConversationResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	var tags []string
+	seen := map[string]bool{}
+	for _, tag := range req.GetTags() {
+		if tag = strings.TrimSpace(tag); tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxNameLength {
+			return nil, errorx.New(errno.ConversationTagInvalidErrCode, errorx.KV("reason", "标签过长"))
+		}
+		seen[tag], tags = true, append(tags, tag)
+	}
+	if len(tags) > maxTags {
+		return nil, errorx.New(errno.ConversationTagInvalidErrCode, errorx.KV("reason", "标签数量过多"))
+	}
+	if err = s.ConversationMapper.UpdateConversationTags(ctx, uid, req.GetConversationId(), tags); err != nil {
+		logs.Errorf("tag conversation error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationTagErrCode)
+	}
+	return &core_api.TagConversationResp{Resp: util.Success(), Tags: tags}, nil
+}
+
+// ListTag 用户使用过的全部标签, 用于筛选对话
+func (s *ConversationService) ListTag(ctx context.Context, req *core_api.ListTagReq) (*core_api.ListTagResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	tags, err := s.ConversationMapper.ListTags(ctx, uid)
+	if err != nil {
+		logs.Errorf("list tag error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationListErrCode)
+	}
+	return &core_api.ListTagResp{Resp: util.Success(), Tags: tags}, nil
+}
+
+// CreateFolder 创建文件夹
+func (s *ConversationService) CreateFolder(ctx context.Context, req *core_api.CreateFolderReq) (*core_api.CreateFolderResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	name, err := folderName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.FolderCreateErrCode)
+	}
+	fs, err := s.FolderMapper.ListFolders(ctx, uid)
+	if err != nil {
+		logs.Errorf("list folder error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.FolderCreateErrCode)
+	}
+	if len(fs) >= maxFolders {
+		return nil, errorx.New(errno.FolderNameInvalidErrCode, errorx.KV("reason", "文件夹数量已达上限"))
+	}
+	f := &folder.Folder{UserId: oid, Name: name}
+	if err = s.FolderMapper.Insert(ctx, f); err != nil {
+		logs.Errorf("insert folder error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.FolderCreateErrCode)
+	}
+	return &core_api.CreateFolderResp{Resp: util.Success(), Folder: folderItem(f)}, nil
+}
+
+// ListFolder 用户的全部文件夹, 按创建时间正序
+func (s *ConversationService) ListFolder(ctx context.Context, req *core_api.ListFolderReq) (*core_api.ListFolderResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	fs, err := s.FolderMapper.ListFolders(ctx, uid)
+	if err != nil {
+		logs.Errorf("list folder error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.FolderListErrCode)
+	}
+	items := make([]*core_api.Folder, len(fs))
+	for i, f := range fs {
+		items[i] = folderItem(f)
+	}
+	return &core_api.ListFolderResp{Resp: util.Success(), Folders: items}, nil
+}
+
+// RenameFolder 重命名文件夹
+func (s *ConversationService) RenameFolder(ctx context.Context, req *core_api.RenameFolderReq) (*core_api.RenameFolderResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	name, err := folderName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.FolderMapper.Rename(ctx, uid, req.GetFolderId(), name); errors.Is(err, monc.ErrNotFound) {
+		return nil, errorx.New(errno.FolderNotFoundErrCode)
+	} else if err != nil {
+		logs.Errorf("rename folder error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.FolderRenameErrCode)
+	}
+	return &core_api.RenameFolderResp{Resp: util.Success()}, nil
+}
+
+// DeleteFolder 删除文件夹, 其中的对话移出文件夹而不会被删除
+func (s *ConversationService) DeleteFolder(ctx context.Context, req *core_api.DeleteFolderReq) (*core_api.DeleteFolderResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	f, err := s.FolderMapper.GetFolder(ctx, uid, req.GetFolderId())
+	if err != nil {
+		logs.Errorf("get folder error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.New(errno.FolderNotFoundErrCode)
+	}
+	if err = s.FolderMapper.Delete(ctx, uid, req.GetFolderId()); err != nil {
+		logs.Errorf("delete folder error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.FolderDeleteErrCode)
+	}
+	if err = s.ConversationMapper.ClearFolder(ctx, uid, f.FolderId); err != nil {
+		logs.Errorf("clear folder error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.FolderDeleteErrCode)
+	}
+	return &core_api.DeleteFolderResp{Resp: util.Success()}, nil
+}
+
+// folderName 校验文件夹名称
+func folderName(name string) (string, error) {
+	if name = strings.TrimSpace(name); name == "" {
+		return "", errorx.New(errno.FolderNameInvalidErrCode, errorx.KV("reason", "名称不能为空"))
+	} else if utf8.RuneCountInString(name) > maxNameLength {
+		return "", errorx.New(errno.FolderNameInvalidErrCode, errorx.KV("reason", "名称过长"))
+	}
+	return name, nil
+}
+
+func folderItem(f *folder.Folder) *core_api.Folder {
+	return &core_api.Folder{
+		FolderId:   f.FolderId.Hex(),
+		Name:       f.Name,
+		CreateTime: f.CreateTime.Unix(),
+		UpdateTime: f.UpdateTime.Unix(),
+	}
+}
@@ -1,13 +1,17 @@
 package conversation
 
 import (
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/history"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/folder"
 	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
 )
 
-func InitConversationSVC(conversation conversation.MongoMapper, message mmsg.MongoMapper) {
+func InitConversationSVC(conversation conversation.MongoMapper, message mmsg.MongoMapper, folder folder.MongoMapper, his *history.HistoryManager) {
 	ConversationSVC = &ConversationService{
 		ConversationMapper: conversation,
 		MessageMapper:      message,
+		FolderMapper:       folder,
+		His:                his,
 	}
 }
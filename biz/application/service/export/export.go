@@ -0,0 +1,307 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
+	"github.com/tencentyun/cos-go-sdk-v5"
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	dexport "github.com/xh-polaris/innospark-core-api/biz/domain/export"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/user"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/storage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/ac"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var ExportSVC *ExportService
+
+const (
+	statusPrefix = "inno:export:status:" // 批量导出任务状态
+	lockPrefix   = "inno:export:lock:"   // 批量导出任务锁, 同一用户同时只有一个任务
+
+	StatusRunning = "running"
+	StatusDone    = "done"
+	StatusFailed  = "failed"
+
+	importBrief = "导入的对话" // 导入内容没有标题时的默认标题
+)
+
+// ExportService 对话导入与导出
+type ExportService struct {
+	ConversationMapper conversation.MongoMapper
+	MessageMapper      mmsg.MongoMapper
+	Cache              cache.Cmdable
+	Cos                storage.COS
+	UserMapper         user.MongoMapper
+}
+
+// ExportConversation 导出单个对话, 渲染结果以分块传输的方式流式下载
+func (s *ExportService) ExportConversation(ctx context.Context, c *app.RequestContext, req *core_api.ExportConversationReq) error {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	if dexport.ContentType(req.GetFormat()) == "" {
+		return errorx.New(errno.ExportFormatErrCode)
+	}
+
+	conv, err := s.ConversationMapper.GetConversation(ctx, req.GetConversationId())
+	if err != nil || conv.UserId.Hex() != uid || conv.Status == cst.DeletedStatus {
+		logs.Errorf("get conversation error: %v", err)
+		return errorx.New(errno.ExportErrCode)
+	}
+	msgs, err := s.activePath(ctx, conv)
+	if err != nil {
+		logs.Errorf("get conversation messages error: %s", errorx.ErrorWithoutStack(err))
+		return errorx.WrapByCode(err, errno.ExportErrCode)
+	}
+
+	c.SetContentType(dexport.ContentType(req.GetFormat()))
+	c.Header("Content-Disposition", "attachment; filename*=UTF-8''"+url.PathEscape(dexport.FileName(conv, req.GetFormat())))
+	c.Response.HijackWriter(resp.NewChunkedBodyWriter(&c.Response, c.GetWriter()))
+	if err = dexport.Render(&flushWriter{c: c}, req.GetFormat(), conv, msgs); err != nil { // 响应已开始, 只记录错误
+		logs.Errorf("render conversation %s error: %s", conv.ConversationId.Hex(), errorx.ErrorWithoutStack(err))
+	}
+	return nil
+}
+
+// ExportAll 异步导出用户的全部对话, 压缩后上传到对象存储, 通过GetExportStatus获取下载链接
+func (s *ExportService) ExportAll(ctx context.Context, req *core_api.ExportAllReq) (*core_api.ExportAllResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	if dexport.ContentType(req.GetFormat()) == "" {
+		return nil, errorx.New(errno.ExportFormatErrCode)
+	}
+
+	c := exportConf()
+	timeout := time.Duration(c.Timeout) * time.Second
+	if ok, err := s.Cache.SetNX(ctx, lockPrefix+uid, 1, timeout).Result(); err != nil {
+		logs.Errorf("lock export job error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ExportErrCode)
+	} else if !ok {
+		return nil, errorx.New(errno.ExportRunningErrCode)
+	}
+	status := &core_api.ExportStatus{Status: StatusRunning, Format: req.GetFormat(), CreateTime: time.Now().Unix()}
+	if err = s.saveStatus(ctx, uid, status, timeout); err != nil {
+		_ = s.Cache.Del(ctx, lockPrefix+uid).Err()
+		logs.Errorf("save export status error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ExportErrCode)
+	}
+
+	go func() {
+		bg := context.WithoutCancel(ctx) // 导出超时后仍需记录结果并释放锁
+		ctx, cancel := context.WithTimeout(bg, timeout)
+		defer cancel()
+		defer func() { _ = s.Cache.Del(bg, lockPrefix+uid).Err() }()
+		expire := time.Duration(c.URLExpire) * time.Second
+		result := &core_api.ExportStatus{Status: status.Status, Format: status.Format, CreateTime: status.CreateTime}
+		if err := s.exportAll(ctx, uid, result, expire); err != nil {
+			logs.Errorf("export conversations of user %s error: %s", uid, errorx.ErrorWithoutStack(err))
+			result.Status, result.Url = StatusFailed, ""
+		}
+		if err := s.saveStatus(bg, uid, result, expire); err != nil {
+			logs.Errorf("save export status error: %s", errorx.ErrorWithoutStack(err))
+		}
+	}()
+	return &core_api.ExportAllResp{Resp: util.Success(), Status: status}, nil
+}
+
+// GetExportStatus 获取最近一次批量导出任务的状态
+func (s *ExportService) GetExportStatus(ctx context.Context, req *core_api.GetExportStatusReq) (*core_api.GetExportStatusResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	data, err := s.Cache.Get(ctx, statusPrefix+uid).Result()
+	if errors.Is(err, cache.Nil) {
+		return &core_api.GetExportStatusResp{Resp: util.Success()}, nil
+	} else if err != nil {
+		logs.Errorf("get export status error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ExportErrCode)
+	}
+	var status core_api.ExportStatus
+	if err = sonic.UnmarshalString(data, &status); err != nil {
+		return nil, errorx.WrapByCode(err, errno.ExportErrCode)
+	}
+	return &core_api.GetExportStatusResp{Resp: util.Success(), Status: &status}, nil
+}
+
+// exportAll 将全部对话分别渲染后写入临时的压缩文件, 上传后生成下载链接
+func (s *ExportService) exportAll(ctx context.Context, uid string, status *core_api.ExportStatus, expire time.Duration) (err error) {
+	convs, err := s.ConversationMapper.ListAllConversations(ctx, uid)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp("", "export-*.zip")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	zw := zip.NewWriter(f)
+	for _, conv := range convs {
+		msgs, err := s.activePath(ctx, conv)
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			continue
+		}
+		// 同名对话以对话id区分
+		name := strings.TrimSuffix(dexport.FileName(conv, status.Format), "."+status.Format)
+		w, err := zw.Create(fmt.Sprintf("%s_%s.%s", name, conv.ConversationId.Hex(), status.Format))
+		if err != nil {
+			return err
+		}
+		if err = dexport.Render(w, status.Format, conv, msgs); err != nil {
+			return err
+		}
+		status.Count++
+	}
+	if err = zw.Close(); err != nil {
+		return err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	key := strings.Join([]string{uid, "export", time.Now().Format("20060102150405") + ".zip"}, "/")
+	if _, err = s.Cos.Upload(ctx, key, f, &cos.ObjectPutOptions{
+		ObjectPutHeaderOptions: &cos.ObjectPutHeaderOptions{ContentType: "application/zip"},
+	}); err != nil {
+		return err
+	}
+	if status.Url, err = s.Cos.GenDownloadURL(ctx, key, expire); err != nil {
+		return err
+	}
+	status.Status, status.ExpireTime = StatusDone, time.Now().Add(expire).Unix()
+	return nil
+}
+
+// ImportConversation 从导出的JSONL或OpenAI格式的消息中导入一个新对话, 导入内容需要通过违禁词审核
+func (s *ExportService) ImportConversation(ctx context.Context, req *core_api.ImportConversationReq) (*core_api.ImportConversationResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	if _, _, forbidden, expire, err := s.UserMapper.CheckForbidden(ctx, uid); err != nil {
+		return nil, errorx.WrapByCode(err, errno.ImportErrCode)
+	} else if forbidden { // 封禁中
+		return nil, errorx.New(errno.ErrForbidden, errorx.KV("time", expire.Local().Format(time.RFC3339)))
+	}
+
+	imp, err := dexport.Parse(req.GetFormat(), req.GetData())
+	if err != nil {
+		return nil, errorx.New(errno.ImportInvalidErrCode, errorx.KV("reason", err.Error()))
+	}
+	brief := util.ZeroDefault(req.GetBrief(), util.ZeroDefault(imp.Brief, importBrief))
+	// 检查标题与导入内容是否有违禁词
+	texts := []string{brief}
+	for _, msg := range imp.Messages {
+		texts = append(texts, strings.Join(msg.Texts(), "\n"))
+	}
+	for _, text := range texts {
+		if sensitive, hits := ac.AcSearch(text, true, cst.SensitivePre); sensitive {
+			if err = s.UserMapper.Warn(ctx, uid); err != nil {
+				logs.Errorf("warn err: %v", err)
+			}
+			return nil, errorx.New(errno.ErrSensitive, errorx.KV("text", strings.Join(hits, ",")))
+		}
+	}
+
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ImportErrCode)
+	}
+	now, cid := time.Now(), primitive.NewObjectID()
+	c := &conversation.Conversation{
+		ConversationId: cid,
+		UserId:         oid,
+		Brief:          brief,
+		BotId:          util.ZeroDefault(req.GetBotId(), imp.BotId),
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	msgs := dexport.ImportMMsgs(imp, oid, cid)
+	// 先写入消息再创建对话, 避免出现没有消息的对话
+	if err = s.MessageMapper.InsertMany(ctx, msgs); err != nil {
+		logs.Errorf("insert import messages error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ImportErrCode)
+	}
+	if err = s.ConversationMapper.InsertConversation(ctx, c); err != nil {
+		logs.Errorf("insert import conversation error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ImportErrCode)
+	}
+	return &core_api.ImportConversationResp{Resp: util.Success(), ConversationId: cid.Hex(), Brief: c.Brief, Count: int32(len(msgs))}, nil
+}
+
+// activePath 对话中各段落当前激活的路径, 按索引倒序
+func (s *ExportService) activePath(ctx context.Context, conv *conversation.Conversation) ([]*mmsg.Message, error) {
+	all, err := s.MessageMapper.RetrieveMessages(ctx, conv.ConversationId.Hex(), "", 0)
+	if err != nil {
+		return nil, err
+	}
+	return mmsg.NewTree(all).ActivePath(), nil
+}
+
+func (s *ExportService) saveStatus(ctx context.Context, uid string, status *core_api.ExportStatus, expire time.Duration) error {
+	data, err := sonic.MarshalString(status)
+	if err != nil {
+		return err
+	}
+	return s.Cache.Set(ctx, statusPrefix+uid, data, expire).Err()
+}
+
+// flushWriter 将写入的内容立即发送给客户端
+type flushWriter struct {
+	c *app.RequestContext
+}
+
+func (w *flushWriter) Write(p []byte) (int, error) {
+	return w.c.Write(p)
+}
+
+func (w *flushWriter) Flush() error {
+	return w.c.Flush()
+}
+
+func exportConf() *conf.Export {
+	if c := conf.GetConfig().Export; c != nil {
+		return c
+	}
+	return &conf.Export{URLExpire: 86400, Timeout: 600}
+}
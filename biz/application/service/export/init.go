@@ -0,0 +1,19 @@
+package export
+
+import (
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/user"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/storage"
+)
+
+func InitExportSVC(conversation conversation.MongoMapper, message mmsg.MongoMapper, cache cache.Cmdable, cos storage.COS, user user.MongoMapper) {
+	ExportSVC = &ExportService{
+		ConversationMapper: conversation,
+		MessageMapper:      message,
+		Cache:              cache,
+		Cos:                cos,
+		UserMapper:         user,
+	}
+}
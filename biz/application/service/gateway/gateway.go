@@ -0,0 +1,251 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/eino/schema"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/sse"
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/apikey"
+	dgateway "github.com/xh-polaris/innospark-core-api/biz/domain/gateway"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/model"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	musage "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/user"
+	"github.com/xh-polaris/innospark-core-api/pkg/ac"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var GatewaySVC *GatewayService
+
+const ownedBy = "innospark"
+
+// GatewayService OpenAI兼容接口, 通过模型注册表直接调用模型, 不经过对话流程也不存储历史记录
+type GatewayService struct {
+	UserMapper  user.MongoMapper
+	UsageMapper musage.MongoMapper
+}
+
+// ListModels 对外开放的模型列表
+func (s *GatewayService) ListModels(ctx context.Context) (*dgateway.ModelList, error) {
+	// 鉴权
+	if _, err := adaptor.ExtractUserId(ctx); err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	list := &dgateway.ModelList{Object: dgateway.ObjectList, Data: []*dgateway.Model{}}
+	for _, name := range models() {
+		if !apikey.Allow(ctx, name) {
+			continue
+		}
+		list.Data = append(list.Data, &dgateway.Model{Id: name, Object: dgateway.ObjectModel, OwnedBy: ownedBy})
+	}
+	return list, nil
+}
+
+// ChatCompletions 对话补全, 流式响应时直接写入响应体并返回nil
+func (s *GatewayService) ChatCompletions(ctx context.Context, c *app.RequestContext, req *dgateway.ChatCompletionReq) (*dgateway.ChatCompletionResp, error) {
+	// 鉴权
+	start := time.Now()
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	// 封禁判断
+	u, _, forbidden, expire, err := s.UserMapper.CheckForbidden(ctx, uid)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.GatewayErrCode)
+	} else if forbidden {
+		return nil, errorx.New(errno.ErrForbidden, errorx.KV("time", expire.Local().Format(time.RFC3339)))
+	}
+	if !available(req.Model) {
+		return nil, errorx.New(errno.GatewayModelNotFoundErrCode, errorx.KV("model", req.Model))
+	}
+	if err = apikey.Check(ctx, req.Model); err != nil {
+		return nil, err
+	}
+	in, err := dgateway.ToMessages(req.Messages)
+	if err != nil {
+		return nil, errorx.New(errno.GatewayInvalidErrCode, errorx.KV("reason", err.Error()))
+	}
+	// 检查输入是否有违禁词
+	if sensitive, hits := ac.AcSearch(dgateway.Texts(in), true, cst.SensitivePre); sensitive {
+		if err = s.UserMapper.Warn(ctx, uid); err != nil {
+			logs.Errorf("warn err: %v", err)
+		}
+		return nil, errorx.New(errno.ErrSensitive, errorx.KV("text", strings.Join(hits, ",")))
+	}
+	// 限流与token配额
+	ticket, err := limit.Acquire(ctx, c, uid, u.Tier, req.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	// 调用模型, 非流式响应同样使用流式调用, 以便统一处理深度思考内容
+	cm, err := model.GetModel(ctx, req.Model, uid, "")
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.GatewayErrCode)
+	}
+	sr, err := cm.Stream(ctx, in, req.Options()...)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[gateway] stream model %s err: %s", req.Model, errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.GatewayErrCode)
+	}
+	defer sr.Close()
+
+	id, created := "chatcmpl-"+primitive.NewObjectID().Hex(), time.Now().Unix()
+	col := &dgateway.Collector{}
+	defer func() { s.account(ctx, u, req.Model, ticket, col, in, start) }() // 中途断开时按已输出的内容计入用量
+	if req.Stream {
+		s.stream(ctx, c, req, in, sr, col, id, created)
+		return nil, nil
+	}
+	for {
+		msg, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			logs.CtxErrorf(ctx, "[gateway] recv model %s err: %s", req.Model, errorx.ErrorWithoutStack(err))
+			return nil, errorx.WrapByCode(err, errno.GatewayErrCode)
+		}
+		col.Add(msg)
+	}
+	col.Close()
+	return &dgateway.ChatCompletionResp{
+		Id:      id,
+		Object:  dgateway.ObjectCompletion,
+		Created: created,
+		Model:   req.Model,
+		Choices: []*dgateway.Choice{{
+			Message: &dgateway.OutMessage{
+				Role:             string(schema.Assistant),
+				Content:          col.Content.String(),
+				ReasoningContent: col.Reasoning.String(),
+			},
+			FinishReason: col.Finish(),
+		}},
+		Usage: col.UsageOf(in),
+	}, nil
+}
+
+// stream 以OpenAI的分片格式写入流式响应, 响应开始后的错误以error分片通知客户端
+func (s *GatewayService) stream(ctx context.Context, c *app.RequestContext, req *dgateway.ChatCompletionReq, in []*schema.Message,
+	sr *schema.StreamReader[*schema.Message], col *dgateway.Collector, id string, created int64) {
+	w := sse.NewWriter(c)
+	defer func() { _ = w.Close() }()
+	write := func(v any) bool {
+		data, err := sonic.Marshal(v)
+		if err == nil {
+			err = w.Write(&sse.Event{Data: data})
+		}
+		if err != nil {
+			logs.CtxErrorf(ctx, "[gateway] write chunk err: %s", errorx.ErrorWithoutStack(err))
+			return false
+		}
+		return true
+	}
+	chunk := func(delta *dgateway.OutMessage, finish *string) *dgateway.ChatCompletionChunk {
+		return &dgateway.ChatCompletionChunk{Id: id, Object: dgateway.ObjectChunk, Created: created, Model: req.Model,
+			Choices: []*dgateway.Choice{{Delta: delta, FinishReason: finish}}}
+	}
+
+	if !write(chunk(&dgateway.OutMessage{Role: string(schema.Assistant)}, nil)) {
+		return
+	}
+	for {
+		msg, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			logs.CtxErrorf(ctx, "[gateway] recv model %s err: %s", req.Model, errorx.ErrorWithoutStack(err))
+			_, e := adaptor.OpenAIError(errorx.WrapByCode(err, errno.GatewayErrCode))
+			write(&dgateway.ErrorResp{Error: e})
+			return
+		}
+		if delta := col.Add(msg); delta != nil && !write(chunk(delta, nil)) {
+			return
+		}
+	}
+	if delta := col.Close(); delta != nil && !write(chunk(delta, nil)) {
+		return
+	}
+	if !write(chunk(&dgateway.OutMessage{}, col.Finish())) {
+		return
+	}
+	if req.StreamOptions != nil && req.StreamOptions.IncludeUsage {
+		usage := &dgateway.ChatCompletionChunk{Id: id, Object: dgateway.ObjectChunk, Created: created, Model: req.Model,
+			Choices: []*dgateway.Choice{}, Usage: col.UsageOf(in)}
+		if !write(usage) {
+			return
+		}
+	}
+	_ = w.Write(&sse.Event{Data: []byte(dgateway.DoneData)})
+}
+
+// account 将本次调用的用量计入API密钥与用户配额, 并记录用量流水
+func (s *GatewayService) account(ctx context.Context, u *user.User, model string, ticket *limit.Ticket, col *dgateway.Collector, in []*schema.Message, start time.Time) {
+	usage := col.UsageOf(in)
+	apikey.Charge(ctx, int64(usage.TotalTokens))
+	ticket.Consume(ctx, int64(usage.TotalTokens))
+
+	r := &musage.Record{
+		UserId:           u.ID,
+		Source:           musage.SourceGateway,
+		Model:            model,
+		PromptTokens:     int64(usage.PromptTokens),
+		CompletionTokens: int64(usage.CompletionTokens),
+		TotalTokens:      int64(usage.TotalTokens),
+		Estimated:        col.Usage == nil,
+		Latency:          time.Since(start).Milliseconds(),
+	}
+	if col.Usage != nil {
+		r.CachedTokens = int64(col.Usage.PromptTokenDetails.CachedTokens)
+	}
+	if k := adaptor.ExtractAPIKey(ctx); k != nil {
+		r.KeyId = k.KeyId
+	}
+	if err := s.UsageMapper.Insert(context.WithoutCancel(ctx), r); err != nil {
+		logs.CtxErrorf(ctx, "[gateway] record usage err: %s", errorx.ErrorWithoutStack(err))
+	}
+}
+
+// defaultModels 未配置时对外开放的模型, 不包括需要智能体id的coze与内部使用的模型
+func defaultModels() []string {
+	return []string{model.DefaultModel, model.DeepThinkModel, model.InnoSpark235B, model.InnoSparkVL, model.InnoSparkRVL,
+		model.Claude4Sonnet, model.DoubaoFlash}
+}
+
+// models 对外开放且已注册的模型
+func models() (names []string) {
+	list := defaultModels()
+	if c := conf.GetConfig().Gateway; c != nil && len(c.Models) > 0 {
+		list = c.Models
+	}
+	for _, name := range list {
+		if model.Registered(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func available(name string) bool {
+	for _, m := range models() {
+		if m == name {
+			return true
+		}
+	}
+	return false
+}
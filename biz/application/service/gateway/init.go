@@ -0,0 +1,13 @@
+package gateway
+
+import (
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/user"
+)
+
+func InitGatewaySVC(user user.MongoMapper, usage usage.MongoMapper) {
+	GatewaySVC = &GatewayService{
+		UserMapper:  user,
+		UsageMapper: usage,
+	}
+}
@@ -2,14 +2,14 @@ package manage
 
 import (
 	"context"
-	"sort"
 	"time"
 
 	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
-	"github.com/xh-polaris/innospark-core-api/biz/application/dto/basic"
 	"github.com/xh-polaris/innospark-core-api/biz/application/dto/manage"
 	"github.com/xh-polaris/innospark-core-api/biz/conf"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/feedback"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/user"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
 	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
@@ -21,6 +21,8 @@ var ManageSVC *ManageService
 type ManageService struct {
 	UserMapper     user.MongoMapper
 	FeedbackMapper feedback.MongoMapper
+	MessageMapper  message.MongoMapper
+	UsageMapper    usage.MongoMapper
 }
 
 func (m *ManageService) AdminLogin(ctx context.Context, req *manage.AdminLoginReq) (resp *manage.AdminLoginResp, err error) {
@@ -40,22 +42,7 @@ func (m *ManageService) ListUser(ctx context.Context, req *manage.ListUserReq) (
 	}
 	var users []*manage.User
 	for _, u := range us {
-		var expire int64
-		if !u.Expire.IsZero() {
-			expire = u.Expire.Unix()
-		}
-		users = append(users, &manage.User{
-			Id:         u.ID.Hex(),
-			Phone:      u.Phone,
-			Name:       u.Name,
-			Avatar:     u.Avatar,
-			Warnings:   u.Warnings,
-			Status:     u.Status,
-			Expire:     expire,
-			LoginTime:  u.LoginTime.Unix(),
-			CreateTime: u.CreateTime.Unix(),
-			UpdateTime: u.UpdateTime.Unix(),
-		})
+		users = append(users, toUser(u))
 	}
 	return &manage.ListUserResp{
 		Resp:  util.Success(),
@@ -64,6 +51,25 @@ func (m *ManageService) ListUser(ctx context.Context, req *manage.ListUserReq) (
 	}, nil
 }
 
+func toUser(u *user.User) *manage.User {
+	var expire int64
+	if !u.Expire.IsZero() {
+		expire = u.Expire.Unix()
+	}
+	return &manage.User{
+		Id:         u.ID.Hex(),
+		Phone:      u.Phone,
+		Name:       u.Name,
+		Avatar:     u.Avatar,
+		Warnings:   u.Warnings,
+		Status:     u.Status,
+		Expire:     expire,
+		LoginTime:  u.LoginTime.Unix(),
+		CreateTime: u.CreateTime.Unix(),
+		UpdateTime: u.UpdateTime.Unix(),
+	}
+}
+
 func (m *ManageService) Forbidden(ctx context.Context, req *manage.ForbiddenUserReq) (resp *manage.ForbiddenUserResp, err error) {
 	if err = checkAdmin(ctx); err != nil {
 		return
@@ -114,60 +120,34 @@ func checkAdmin(ctx context.Context) error {
 	return nil
 }
 
+// UserStatistics 按注册时间统计每日新增与累计用户数
 func (m *ManageService) UserStatistics(ctx context.Context, req *manage.UserStatisticsReq) (resp *manage.UserStatisticsResp, err error) {
 	if err = checkAdmin(ctx); err != nil {
 		return
 	}
-	before, err := m.UserMapper.CountUserByCreateTime(ctx, time.Unix(req.Start, 0), false)
+	r, err := newUserStatRange(req.Start, req.End, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	before, err := m.UserMapper.CountUserByCreateTime(ctx, r.start, false)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := m.UserMapper.CountByPeriod(ctx, r.start, r.end, util.PeriodDay, r.tz)
 	if err != nil {
 		return nil, err
 	}
-
-	var size int64 = 5000
-	var page int64 = 1
-	dailyGrowthMap := map[int64]int{}
-	var isTooAfter bool
-	for {
-		_, list, err := m.UserMapper.ListUser(ctx, &basic.Page{Page: &page, Size: &size}, 0, 0, 1)
-		if err != nil {
-			return nil, err
-		}
-		if len(list) == 0 {
-			break // 数据拉完了
-		}
-		// 聚合新增
-		for _, u := range list {
-			if u.CreateTime.Unix() > req.End {
-				isTooAfter = true
-				break
-			} else if u.CreateTime.Unix() < req.Start {
-				continue
-			}
-			day := normalizeDay(u.CreateTime)
-			dailyGrowthMap[day]++
-		}
-		if isTooAfter {
-			break
-		}
-		page++
-	}
-	// 排序日期
-	days := make([]int64, 0, len(dailyGrowthMap))
-	for d := range dailyGrowthMap {
-		days = append(days, d)
-	}
-	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
 	// 每日新增
-	growth := make([]*manage.UserStatisticsResp_Item, 0, len(days))
-	for _, day := range days {
+	growth := make([]*manage.UserStatisticsResp_Item, 0, len(counts))
+	for _, c := range counts {
 		growth = append(growth, &manage.UserStatisticsResp_Item{
-			Date:  day,
-			Count: int64(dailyGrowthMap[day]),
+			Date:  util.ParseDate(c.Date, r.loc),
+			Count: c.Count,
 		})
 	}
 
 	// 累积数据
-	accumulate := make([]*manage.UserStatisticsResp_Item, 0, len(days))
+	accumulate := make([]*manage.UserStatisticsResp_Item, 0, len(growth))
 	var sum = before
 	for _, g := range growth {
 		sum += g.Count
@@ -179,7 +159,10 @@ func (m *ManageService) UserStatistics(ctx context.Context, req *manage.UserStat
 	// 趋势线
 	trend := calcLinearRegression(accumulate)
 	// 平均
-	avgDaily := float64(sum) / float64(len(days))
+	var avgDaily float64
+	if len(growth) > 0 {
+		avgDaily = float64(sum) / float64(len(growth))
+	}
 	return &manage.UserStatisticsResp{
 		Resp:               util.Success(),
 		Growth:             growth,
@@ -190,11 +173,6 @@ func (m *ManageService) UserStatistics(ctx context.Context, req *manage.UserStat
 	}, nil
 }
 
-// 将时间戳归零到当天 00:00:00
-func normalizeDay(t time.Time) int64 {
-	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix()
-}
-
 func calcLinearRegression(acc []*manage.UserStatisticsResp_Item) *manage.UserStatisticsResp_Trend {
 	n := float64(len(acc))
 	if n <= 1 {
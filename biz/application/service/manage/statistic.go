@@ -0,0 +1,223 @@
+package manage
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+	_ "time/tzdata" // 不依赖运行环境的时区数据
+
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/manage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+)
+
+const (
+	defaultTimezone   = "Asia/Shanghai"
+	defaultRetention  = 8  // 默认统计注册后的周数
+	maxRetention      = 26 // 最多统计注册后的周数
+	maxStatisticRange = maxUsageDays * 24 * time.Hour
+	week              = 7 * 24 * time.Hour
+)
+
+// ActiveStatistic 统计日活、周活与月活
+func (m *ManageService) ActiveStatistic(ctx context.Context, req *manage.ActiveStatisticsReq) (resp *manage.ActiveStatisticsResp, err error) {
+	if err = checkAdmin(ctx); err != nil {
+		return
+	}
+	r, err := newStatRange(req.Start, req.End, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	var active [3][]*util.DateCount
+	for i, period := range []string{util.PeriodDay, util.PeriodWeek, util.PeriodMonth} {
+		if active[i], err = m.MessageMapper.ActiveUsers(ctx, r.start, r.end, period, r.tz); err != nil {
+			logs.CtxErrorf(ctx, "[manage] active users err: %s", errorx.ErrorWithoutStack(err))
+			return nil, errorx.WrapByCode(err, errno.StatisticErrCode)
+		}
+	}
+	dau, wau, mau := active[0], active[1], active[2]
+	resp = &manage.ActiveStatisticsResp{
+		Resp:       util.Success(),
+		Dau:        r.dateCounts(dau),
+		Wau:        r.dateCounts(wau),
+		Mau:        r.dateCounts(mau),
+		AverageDau: float64(sumCounts(dau)) / r.days(),
+	}
+	if total := sumCounts(mau); total > 0 {
+		resp.Stickiness = resp.AverageDau / (float64(total) / float64(len(mau)))
+	}
+	return resp, nil
+}
+
+// RetentionStatistic 按注册周统计用户在之后每周的留存
+func (m *ManageService) RetentionStatistic(ctx context.Context, req *manage.RetentionStatisticsReq) (resp *manage.RetentionStatisticsResp, err error) {
+	if err = checkAdmin(ctx); err != nil {
+		return
+	}
+	r, err := newStatRange(req.Start, req.End, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	weeks := req.Weeks
+	if weeks <= 0 {
+		weeks = defaultRetention
+	}
+	weeks = min(weeks, maxRetention)
+	activeEnd := r.end.Add(time.Duration(weeks) * week)
+	if now := time.Now(); activeEnd.After(now) {
+		activeEnd = now
+	}
+
+	sizes, err := m.UserMapper.CountByPeriod(ctx, r.start, r.end, util.PeriodWeek, r.tz)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[manage] cohort size err: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.StatisticErrCode)
+	}
+	rs, err := m.MessageMapper.Retention(ctx, r.start, r.end, activeEnd, r.tz)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[manage] retention err: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.StatisticErrCode)
+	}
+
+	cohorts := make([]*manage.RetentionCohort, 0, len(sizes))
+	index := make(map[string]*manage.RetentionCohort, len(sizes))
+	for _, s := range sizes {
+		start, _ := time.ParseInLocation(util.DateLayout, s.Date, r.loc)
+		// 只统计已经过去的周, 避免未到来的周被当作流失
+		n := min(int64(math.Ceil(float64(activeEnd.Sub(start))/float64(week))), weeks)
+		c := &manage.RetentionCohort{Week: start.Unix(), Users: s.Count, Retained: make([]int64, max(n, 0)), Rates: make([]float64, max(n, 0))}
+		cohorts, index[s.Date] = append(cohorts, c), c
+	}
+	for _, rt := range rs {
+		c, ok := index[rt.Cohort]
+		if !ok {
+			continue
+		}
+		// 按天数取整, 避免夏令时导致的误差
+		i := int(math.Round(float64(util.ParseDate(rt.Week, r.loc)-c.Week) / week.Seconds()))
+		if i >= 0 && i < len(c.Retained) {
+			c.Retained[i] = rt.Count
+		}
+	}
+	for _, c := range cohorts {
+		for i, n := range c.Retained {
+			c.Rates[i] = ratio(n, c.Users)
+		}
+	}
+	return &manage.RetentionStatisticsResp{Resp: util.Success(), Cohorts: cohorts}, nil
+}
+
+// MessageStatistic 按天、模型或智能体统计模型回复的消息量与点赞点踩比例
+func (m *ManageService) MessageStatistic(ctx context.Context, req *manage.MessageStatisticsReq) (resp *manage.MessageStatisticsResp, err error) {
+	if err = checkAdmin(ctx); err != nil {
+		return
+	}
+	r, err := newStatRange(req.Start, req.End, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	switch req.GroupBy {
+	case "", message.StatByDay, message.StatByModel, message.StatByBot:
+	default:
+		return nil, errorx.New(errno.StatisticParamErrCode, errorx.KV("reason", "groupBy"))
+	}
+
+	stats, err := m.MessageMapper.Statistics(ctx, r.start, r.end, req.GroupBy, r.tz)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[manage] message statistics err: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.StatisticErrCode)
+	}
+	items, total := make([]*manage.MessageItem, 0, len(stats)), &message.Stat{}
+	for _, st := range stats {
+		item := messageItem(st)
+		if req.GroupBy == "" || req.GroupBy == message.StatByDay {
+			item.Key = strconv.FormatInt(util.ParseDate(st.Key, r.loc), 10)
+		}
+		items = append(items, item)
+		total.Messages += st.Messages
+		total.Likes += st.Likes
+		total.Dislikes += st.Dislikes
+	}
+	return &manage.MessageStatisticsResp{Resp: util.Success(), Items: items, Total: messageItem(total)}, nil
+}
+
+func messageItem(st *message.Stat) *manage.MessageItem {
+	return &manage.MessageItem{
+		Key:          st.Key,
+		Messages:     st.Messages,
+		Likes:        st.Likes,
+		Dislikes:     st.Dislikes,
+		LikeRate:     ratio(st.Likes, st.Messages),
+		DislikeRate:  ratio(st.Dislikes, st.Messages),
+		Satisfaction: ratio(st.Likes, st.Likes+st.Dislikes),
+	}
+}
+
+func sumCounts(counts []*util.DateCount) (sum int64) {
+	for _, c := range counts {
+		sum += c.Count
+	}
+	return sum
+}
+
+func ratio(n, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
+
+// statRange 统计的时间范围[start, end)与时区
+type statRange struct {
+	start, end time.Time
+	loc        *time.Location
+	tz         string
+}
+
+// newStatRange 校验时间范围与时区, 时区为空时使用默认时区
+func newStatRange(start, end int64, tz string) (*statRange, error) {
+	if start <= 0 || end <= start {
+		return nil, errorx.New(errno.StatisticParamErrCode, errorx.KV("reason", "start/end"))
+	}
+	r := &statRange{start: time.Unix(start, 0), end: time.Unix(end, 0)}
+	if r.end.Sub(r.start) > maxStatisticRange {
+		return nil, errorx.New(errno.StatisticParamErrCode, errorx.KV("reason", "range too long"))
+	}
+	return r.in(util.ZeroDefault(tz, defaultTimezone))
+}
+
+// newUserStatRange 用户统计沿用原有约定: start可以为0, 包含end所在的一秒, 不限制范围, 时区为空时按UTC统计
+func newUserStatRange(start, end int64, tz string) (*statRange, error) {
+	if start < 0 || end < start {
+		return nil, errorx.New(errno.StatisticParamErrCode, errorx.KV("reason", "start/end"))
+	}
+	r := &statRange{start: time.Unix(start, 0), end: time.Unix(end+1, 0)}
+	return r.in(util.ZeroDefault(tz, time.UTC.String()))
+}
+
+// in 设置统计使用的时区
+func (r *statRange) in(tz string) (*statRange, error) {
+	loc, err := time.LoadLocation(tz)
+	if err != nil || tz == "Local" {
+		return nil, errorx.New(errno.StatisticParamErrCode, errorx.KV("reason", "timezone"))
+	}
+	r.loc, r.tz = loc, tz
+	return r, nil
+}
+
+// days 统计范围覆盖的天数, 不足一天按一天计
+func (r *statRange) days() float64 {
+	return math.Max(math.Ceil(r.end.Sub(r.start).Hours()/24), 1)
+}
+
+func (r *statRange) dateCounts(counts []*util.DateCount) []*manage.DateCount {
+	items := make([]*manage.DateCount, 0, len(counts))
+	for _, c := range counts {
+		items = append(items, &manage.DateCount{Date: util.ParseDate(c.Date, r.loc), Count: c.Count})
+	}
+	return items
+}
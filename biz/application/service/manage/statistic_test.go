@@ -0,0 +1,92 @@
+package manage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewStatRange(t *testing.T) {
+	day := int64(24 * 60 * 60)
+	cases := []struct {
+		name       string
+		start, end int64
+		tz         string
+		ok         bool
+		wantTz     string
+	}{
+		{"default timezone", day, 2 * day, "", true, defaultTimezone},
+		{"timezone", day, 2 * day, "America/New_York", true, "America/New_York"},
+		{"zero start", 0, day, "", false, ""},
+		{"end before start", 2 * day, day, "", false, ""},
+		{"empty range", day, day, "", false, ""},
+		{"too long", day, day + 367*day, "", false, ""},
+		{"max range", day, day + maxUsageDays*day, "", true, defaultTimezone},
+		{"invalid timezone", day, 2 * day, "Mars/Base", false, ""},
+		{"local timezone", day, 2 * day, "Local", false, ""},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		r, err := newStatRange(c.start, c.end, c.tz)
+		if !c.ok {
+			g.Expect(err).To(HaveOccurred(), c.name)
+			continue
+		}
+		g.Expect(err).NotTo(HaveOccurred(), c.name)
+		g.Expect(r.tz).To(Equal(c.wantTz), c.name)
+		g.Expect(r.start.Unix()).To(Equal(c.start), c.name)
+		g.Expect(r.end.Unix()).To(Equal(c.end), c.name)
+	}
+}
+
+func TestNewUserStatRange(t *testing.T) {
+	day := int64(24 * 60 * 60)
+	cases := []struct {
+		name       string
+		start, end int64
+		tz         string
+		ok         bool
+		wantTz     string
+	}{
+		{"utc by default", day, 2 * day, "", true, "UTC"},
+		{"timezone", day, 2 * day, "Asia/Shanghai", true, "Asia/Shanghai"},
+		{"zero start", 0, day, "", true, "UTC"},
+		{"same second", day, day, "", true, "UTC"},
+		{"no range limit", 0, 3650 * day, "", true, "UTC"},
+		{"negative start", -1, day, "", false, ""},
+		{"end before start", 2 * day, day, "", false, ""},
+		{"invalid timezone", day, 2 * day, "Mars/Base", false, ""},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		r, err := newUserStatRange(c.start, c.end, c.tz)
+		if !c.ok {
+			g.Expect(err).To(HaveOccurred(), c.name)
+			continue
+		}
+		g.Expect(err).NotTo(HaveOccurred(), c.name)
+		g.Expect(r.tz).To(Equal(c.wantTz), c.name)
+		g.Expect(r.start.Unix()).To(Equal(c.start), c.name)
+		g.Expect(r.end.Unix()).To(Equal(c.end+1), c.name) // end所在的一秒也统计在内
+	}
+}
+
+func TestDays(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	cases := []struct {
+		name string
+		span time.Duration
+		want float64
+	}{
+		{"less than a day", time.Hour, 1},
+		{"one day", 24 * time.Hour, 1},
+		{"partial day", 25 * time.Hour, 2},
+		{"a week", 7 * 24 * time.Hour, 7},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		r := &statRange{start: base, end: base.Add(c.span)}
+		g.Expect(r.days()).To(Equal(c.want), c.name)
+	}
+}
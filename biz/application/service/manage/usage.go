@@ -0,0 +1,121 @@
+package manage
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/manage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+)
+
+const (
+	defaultTopUsers = 10
+	maxTopUsers     = 100
+	maxUsageDays    = 366 // 单次统计的最大天数
+)
+
+// UsageStatistics 按天、模型、用户或智能体统计用量
+func (m *ManageService) UsageStatistics(ctx context.Context, req *manage.UsageStatisticsReq) (resp *manage.UsageStatisticsResp, err error) {
+	if err = checkAdmin(ctx); err != nil {
+		return
+	}
+	opt, err := usageOption(req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	switch req.GroupBy {
+	case "", usage.GroupByDay, usage.GroupByModel, usage.GroupByUser, usage.GroupByBot:
+		opt.GroupBy = req.GroupBy
+	default:
+		return nil, errorx.New(errno.StatisticParamErrCode, errorx.KV("reason", "groupBy"))
+	}
+	opt.Model, opt.UserId, opt.BotId = req.GetModel(), req.GetUserId(), req.GetBotId()
+
+	stats, err := m.UsageMapper.Statistics(ctx, opt)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[manage] usage statistics err: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.StatisticErrCode)
+	}
+	items, total := make([]*manage.UsageItem, 0, len(stats)), &usage.Stat{}
+	for _, st := range stats {
+		items = append(items, usageItem(st))
+		total.Requests += st.Requests
+		total.PromptTokens += st.PromptTokens
+		total.CompletionTokens += st.CompletionTokens
+		total.CachedTokens += st.CachedTokens
+		total.TotalTokens += st.TotalTokens
+		total.SearchCalls += st.SearchCalls
+		total.OCRCalls += st.OCRCalls
+		total.Latency += st.Latency
+	}
+	return &manage.UsageStatisticsResp{Resp: util.Success(), Items: items, Total: usageItem(total)}, nil
+}
+
+// TopUsageUser 统计周期内用量最多的用户
+func (m *ManageService) TopUsageUser(ctx context.Context, req *manage.TopUsageUserReq) (resp *manage.TopUsageUserResp, err error) {
+	if err = checkAdmin(ctx); err != nil {
+		return
+	}
+	opt, err := usageOption(req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	opt.Model, opt.BotId = req.GetModel(), req.GetBotId()
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultTopUsers
+	}
+	limit = min(limit, maxTopUsers)
+
+	stats, err := m.UsageMapper.TopUsers(ctx, opt, limit)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[manage] top usage users err: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.StatisticErrCode)
+	}
+	users := make([]*manage.UserUsage, 0, len(stats))
+	for _, st := range stats {
+		uu := &manage.UserUsage{User: &manage.User{Id: st.Key}, Usage: usageItem(st)}
+		if u, err := m.UserMapper.FindById(ctx, st.Key); err == nil {
+			uu.User = toUser(u)
+		}
+		users = append(users, uu)
+	}
+	return &manage.TopUsageUserResp{Resp: util.Success(), Users: users}, nil
+}
+
+// usageOption 校验统计的时间范围
+func usageOption(start, end int64) (*usage.StatOption, error) {
+	if start <= 0 || end < start {
+		return nil, errorx.New(errno.StatisticParamErrCode, errorx.KV("reason", "start/end"))
+	}
+	s, e := time.Unix(start, 0), time.Unix(end, 0)
+	if e.Sub(s) > maxUsageDays*24*time.Hour {
+		return nil, errorx.New(errno.StatisticParamErrCode, errorx.KV("reason", "range too long"))
+	}
+	return &usage.StatOption{Start: s, End: e}, nil
+}
+
+func usageItem(st *usage.Stat) *manage.UsageItem {
+	item := &manage.UsageItem{
+		Key:              st.Key,
+		Requests:         st.Requests,
+		PromptTokens:     st.PromptTokens,
+		CompletionTokens: st.CompletionTokens,
+		CachedTokens:     st.CachedTokens,
+		TotalTokens:      st.TotalTokens,
+		SearchCalls:      st.SearchCalls,
+		OcrCalls:         st.OCRCalls,
+	}
+	if !st.Date.IsZero() {
+		item.Key = strconv.FormatInt(st.Date.Unix(), 10)
+	}
+	if st.Requests > 0 {
+		item.AvgLatency = st.Latency / st.Requests
+	}
+	return item
+}
@@ -0,0 +1,11 @@
+package memory
+
+import (
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/memory"
+)
+
+func InitMemorySVC(memory memory.MongoMapper) {
+	MemorySVC = &MemoryService{
+		MemoryMapper: memory,
+	}
+}
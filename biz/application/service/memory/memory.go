@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/memory"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+)
+
+var MemorySVC *MemoryService
+
+// MemoryService 用户记忆的查看与删除
+type MemoryService struct {
+	MemoryMapper memory.MongoMapper
+}
+
+func (s *MemoryService) ListMemory(ctx context.Context, req *core_api.ListMemoryReq) (*core_api.ListMemoryResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	memories, hasMore, err := s.MemoryMapper.ListMemories(ctx, uid, req.GetPage())
+	if err != nil {
+		logs.Errorf("list memory error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.MemoryListErrCode)
+	}
+	items := make([]*core_api.UserMemory, len(memories))
+	for i, m := range memories {
+		items[i] = &core_api.UserMemory{
+			MemoryId:       m.MemoryId.Hex(),
+			Content:        m.Content,
+			ConversationId: m.ConversationId.Hex(),
+			CreateTime:     m.CreateTime.Unix(),
+			UpdateTime:     m.UpdateTime.Unix(),
+		}
+	}
+
+	resp := &core_api.ListMemoryResp{Resp: util.Success(), Memories: items, HasMore: hasMore}
+	if len(memories) > 0 {
+		resp.Cursor = memories[len(memories)-1].MemoryId.Hex()
+	}
+	return resp, nil
+}
+
+func (s *MemoryService) DeleteMemory(ctx context.Context, req *core_api.DeleteMemoryReq) (*core_api.DeleteMemoryResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	if len(req.GetMemoryIds()) > 0 {
+		if err = s.MemoryMapper.DeleteMemory(ctx, uid, req.GetMemoryIds()); err != nil {
+			logs.Errorf("delete memory error: %s", errorx.ErrorWithoutStack(err))
+			return nil, errorx.WrapByCode(err, errno.MemoryDeleteErrCode)
+		}
+	}
+	return &core_api.DeleteMemoryResp{Resp: util.Success()}, nil
+}
+
+func (s *MemoryService) ClearMemory(ctx context.Context, req *core_api.ClearMemoryReq) (*core_api.ClearMemoryResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	if err = s.MemoryMapper.ClearMemories(ctx, uid); err != nil {
+		logs.Errorf("clear memory error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.MemoryDeleteErrCode)
+	}
+	return &core_api.ClearMemoryResp{Resp: util.Success()}, nil
+}
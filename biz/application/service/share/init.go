@@ -0,0 +1,19 @@
+package share
+
+import (
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/history"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/share"
+)
+
+func InitShareSVC(share share.MongoMapper, conversation conversation.MongoMapper, message mmsg.MongoMapper, his *history.HistoryManager, cache cache.Cmdable) {
+	ShareSVC = &ShareService{
+		ShareMapper:        share,
+		ConversationMapper: conversation,
+		MessageMapper:      message,
+		His:                his,
+		Cache:              cache,
+	}
+}
@@ -0,0 +1,266 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/history"
+	dm "github.com/xh-polaris/innospark-core-api/biz/domain/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/share"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var ShareSVC *ShareService
+
+const (
+	maxShareMessages = 500 // 单个分享快照的消息数上限
+
+	failPrefix  = "inno:share:fail:" // 分享访问密码的错误次数
+	maxFailures = 10                 // 窗口内允许的密码错误次数, 超过后锁定
+	failWindow  = 15 * time.Minute   // 错误次数的保留时间, 每次错误后重新计时
+)
+
+// ShareService 对话分享, 创建快照与撤销需要登录, 查看分享无需登录
+type ShareService struct {
+	ShareMapper        share.MongoMapper
+	ConversationMapper conversation.MongoMapper
+	MessageMapper      mmsg.MongoMapper
+	His                *history.HistoryManager
+	Cache              cache.Cmdable
+}
+
+// CreateShare 为对话当前激活路径上的一段消息创建不可变的快照
+func (s *ShareService) CreateShare(ctx context.Context, req *core_api.CreateShareReq) (*core_api.CreateShareResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	c, err := s.ConversationMapper.GetConversation(ctx, req.GetConversationId())
+	if err != nil || c.UserId.Hex() != uid || c.Status == cst.DeletedStatus {
+		logs.Errorf("get conversation error: %v", err)
+		return nil, errorx.New(errno.ShareCreateErrCode)
+	}
+	all, err := s.MessageMapper.RetrieveMessages(ctx, req.GetConversationId(), "", 0)
+	if err != nil {
+		logs.Errorf("get conversation messages error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ShareCreateErrCode)
+	}
+	var start, end primitive.ObjectID
+	if req.StartMessageId != nil {
+		if start, err = primitive.ObjectIDFromHex(req.GetStartMessageId()); err != nil {
+			return nil, errorx.WrapByCode(err, errno.ShareCreateErrCode)
+		}
+	}
+	if req.EndMessageId != nil {
+		if end, err = primitive.ObjectIDFromHex(req.GetEndMessageId()); err != nil {
+			return nil, errorx.WrapByCode(err, errno.ShareCreateErrCode)
+		}
+	}
+	msgs := dm.SnapshotMMsgs(mmsg.NewTree(all).ActivePath(), start, end)
+	if len(msgs) == 0 || len(msgs) > maxShareMessages {
+		return nil, errorx.New(errno.ShareCreateErrCode)
+	}
+
+	token, err := share.NewToken()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ShareCreateErrCode)
+	}
+	sh := &share.Share{
+		Token:          token,
+		UserId:         c.UserId,
+		ConversationId: c.ConversationId,
+		Brief:          util.ZeroDefault(req.GetBrief(), c.Brief),
+		BotId:          c.BotId,
+		Messages:       msgs,
+		MessageCount:   int32(len(msgs)),
+	}
+	if req.GetExpireSeconds() > 0 {
+		sh.ExpireTime = time.Now().Add(time.Duration(req.GetExpireSeconds()) * time.Second)
+	}
+	if err = sh.SetPassword(req.GetPassword()); err != nil {
+		return nil, errorx.WrapByCode(err, errno.ShareCreateErrCode)
+	}
+	if err = s.ShareMapper.Insert(ctx, sh); err != nil {
+		logs.Errorf("insert share error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ShareCreateErrCode)
+	}
+	return &core_api.CreateShareResp{Resp: util.Success(), Share: shareInfo(sh)}, nil
+}
+
+// ListShare 分页查询当前用户创建的分享
+func (s *ShareService) ListShare(ctx context.Context, req *core_api.ListShareReq) (*core_api.ListShareResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	shares, hasMore, err := s.ShareMapper.ListShares(ctx, uid, req.GetPage())
+	if err != nil {
+		logs.Errorf("list share error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ShareListErrCode)
+	}
+	items := make([]*core_api.ShareInfo, len(shares))
+	for i, sh := range shares {
+		items[i] = shareInfo(sh)
+	}
+	resp := &core_api.ListShareResp{Resp: util.Success(), Shares: items, HasMore: hasMore}
+	if len(shares) > 0 {
+		resp.Cursor = shares[len(shares)-1].ShareId.Hex()
+	}
+	return resp, nil
+}
+
+// RevokeShare 撤销分享, 撤销后链接立即失效
+func (s *ShareService) RevokeShare(ctx context.Context, req *core_api.RevokeShareReq) (*core_api.RevokeShareResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	if err = s.ShareMapper.Revoke(ctx, uid, req.GetToken()); err != nil {
+		logs.Errorf("revoke share error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ShareRevokeErrCode)
+	}
+	return &core_api.RevokeShareResp{Resp: util.Success()}, nil
+}
+
+// ViewShare 公开查看分享的快照, 无需登录, 每次查看计入访问次数
+func (s *ShareService) ViewShare(ctx context.Context, req *core_api.ViewShareReq) (*core_api.ViewShareResp, error) {
+	sh, err := s.access(ctx, req.GetToken(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.ShareMapper.IncrViews(ctx, sh.ShareId); err != nil {
+		logs.Errorf("incr share views error: %s", errorx.ErrorWithoutStack(err))
+	}
+	resp := &core_api.ViewShareResp{
+		Resp:        util.Success(),
+		Brief:       sh.Brief,
+		BotId:       sh.BotId,
+		MessageList: dm.MMsgToFMsgList(sh.Messages),
+		Views:       sh.Views + 1,
+		CreateTime:  sh.CreateTime.Unix(),
+	}
+	if !sh.ExpireTime.IsZero() {
+		resp.ExpireTime = sh.ExpireTime.Unix()
+	}
+	return resp, nil
+}
+
+// ContinueShare 将分享的快照复制为当前用户的新对话, 以便在此基础上继续对话
+func (s *ShareService) ContinueShare(ctx context.Context, req *core_api.ContinueShareReq) (*core_api.ContinueShareResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ShareContinueErrCode)
+	}
+
+	sh, err := s.access(ctx, req.GetToken(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	now, cid := time.Now(), primitive.NewObjectID()
+	msgs, sections := dm.ForkMMsgs(sh.Messages, cid)
+	for _, msg := range msgs {
+		msg.UserId = oid
+	}
+	c := &conversation.Conversation{
+		ConversationId: cid,
+		UserId:         oid,
+		Brief:          sh.Brief,
+		BotId:          sh.BotId,
+		CreateTime:     now,
+		UpdateTime:     now,
+		Sections:       sections,
+	}
+	// 先写入消息再创建对话, 避免出现没有消息的对话
+	if err = s.MessageMapper.InsertMany(ctx, msgs); err != nil {
+		logs.Errorf("insert share messages error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ShareContinueErrCode)
+	}
+	if err = s.ConversationMapper.InsertConversation(ctx, c); err != nil {
+		logs.Errorf("insert share conversation error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ShareContinueErrCode)
+	}
+	// 重建当前段落的历史记录缓存
+	var current []*mmsg.Message
+	for _, msg := range msgs {
+		if msg.SectionId == c.CurrentSection().SectionId {
+			current = append(current, msg)
+		}
+	}
+	if err = s.His.CacheMessages(ctx, cid.Hex(), current, true); err != nil {
+		logs.Errorf("cache share messages error: %s", errorx.ErrorWithoutStack(err))
+	}
+	return &core_api.ContinueShareResp{Resp: util.Success(), ConversationId: cid.Hex(), Brief: c.Brief}, nil
+}
+
+// access 获取可访问的分享, 校验是否过期与访问密码, 同一分享的密码错误次数过多时暂时锁定
+func (s *ShareService) access(ctx context.Context, token, password string) (*share.Share, error) {
+	sh, err := s.ShareMapper.GetByToken(ctx, token)
+	if err != nil {
+		logs.Errorf("get share error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.New(errno.ShareNotFoundErrCode)
+	}
+	if sh.Expired() {
+		return nil, errorx.New(errno.ShareExpiredErrCode)
+	}
+	if sh.Password == "" {
+		return sh, nil
+	}
+	key := failPrefix + token
+	if failures, err := s.Cache.Get(ctx, key).Int64(); err != nil && !errors.Is(err, cache.Nil) {
+		logs.Errorf("get share failures error: %s", errorx.ErrorWithoutStack(err))
+	} else if failures >= maxFailures {
+		return nil, errorx.New(errno.ShareLockedErrCode)
+	}
+	if !sh.CheckPassword(password) {
+		pipe := s.Cache.Pipeline()
+		pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, failWindow)
+		if _, err = pipe.Exec(ctx); err != nil {
+			logs.Errorf("incr share failures error: %s", errorx.ErrorWithoutStack(err))
+		}
+		return nil, errorx.New(errno.SharePasswordErrCode)
+	}
+	return sh, nil
+}
+
+func shareInfo(sh *share.Share) *core_api.ShareInfo {
+	info := &core_api.ShareInfo{
+		Token:          sh.Token,
+		ConversationId: sh.ConversationId.Hex(),
+		Brief:          sh.Brief,
+		MessageCount:   sh.MessageCount,
+		HasPassword:    sh.Password != "",
+		Views:          sh.Views,
+		CreateTime:     sh.CreateTime.Unix(),
+	}
+	if !sh.ExpireTime.IsZero() {
+		info.ExpireTime = sh.ExpireTime.Unix()
+	}
+	return info
+}
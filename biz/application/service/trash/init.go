@@ -0,0 +1,24 @@
+package trash
+
+import (
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/history"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/feedback"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/share"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/storage"
+)
+
+func InitTrashSVC(conversation conversation.MongoMapper, message mmsg.MongoMapper, feedback feedback.MongoMapper,
+	share share.MongoMapper, his *history.HistoryManager, cos storage.COS, cache cache.Cmdable) {
+	TrashSVC = &TrashService{
+		ConversationMapper: conversation,
+		MessageMapper:      message,
+		FeedbackMapper:     feedback,
+		ShareMapper:        share,
+		His:                his,
+		Cos:                cos,
+		Cache:              cache,
+	}
+}
@@ -0,0 +1,220 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/core_api"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/history"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/feedback"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/share"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/storage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var TrashSVC *TrashService
+
+const purgeLock = "inno:trash:purge:lock" // 清理任务锁, 多实例部署时同一时间只有一个实例执行
+
+// TrashService 回收站, 删除的对话保留一段时间后彻底删除
+type TrashService struct {
+	ConversationMapper conversation.MongoMapper
+	MessageMapper      mmsg.MongoMapper
+	FeedbackMapper     feedback.MongoMapper
+	ShareMapper        share.MongoMapper
+	His                *history.HistoryManager
+	Cos                storage.COS
+	Cache              cache.Cmdable
+}
+
+// ListTrash 分页获取回收站中的对话, 按删除时间倒序
+func (s *TrashService) ListTrash(ctx context.Context, req *core_api.ListTrashReq) (*core_api.ListTrashResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	conversations, hasMore, err := s.ConversationMapper.ListTrash(ctx, uid, req.GetPage())
+	if err != nil {
+		logs.Errorf("list trash error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationListTrashErrCode)
+	}
+	retention := retention()
+	items := make([]*core_api.Conversation, len(conversations))
+	for i, conv := range conversations {
+		items[i] = &core_api.Conversation{
+			ConversationId: conv.ConversationId.Hex(),
+			Brief:          conv.Brief,
+			BotId:          conv.BotId,
+			CreateTime:     conv.CreateTime.Unix(),
+			UpdateTime:     conv.UpdateTime.Unix(),
+			Ext:            conv.Ext,
+			Pinned:         conv.Pinned,
+			Tags:           conv.Tags,
+			DeleteTime:     conv.DeleteTime.Unix(),
+			PurgeTime:      conv.DeleteTime.Add(retention).Unix(),
+		}
+		if !conv.FolderId.IsZero() {
+			items[i].FolderId = conv.FolderId.Hex()
+		}
+	}
+
+	resp := &core_api.ListTrashResp{Resp: util.Success(), Conversations: items, HasMore: hasMore}
+	if len(conversations) > 0 {
+		resp.Cursor = conversations[len(conversations)-1].ConversationId.Hex()
+	}
+	return resp, nil
+}
+
+// RestoreConversation 从回收站恢复对话及随对话一起删除的消息
+func (s *TrashService) RestoreConversation(ctx context.Context, req *core_api.RestoreConversationReq) (*core_api.RestoreConversationResp, error) {
+	// 鉴权
+	uid, err := adaptor.ExtractUserId(ctx)
+	if err != nil {
+		logs.Errorf("extract user id error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.UnAuthErrCode)
+	}
+
+	// 先恢复对话以校验归属, 再还原其中的消息
+	if err = s.ConversationMapper.RestoreConversation(ctx, uid, req.GetConversationId()); errors.Is(err, monc.ErrNotFound) {
+		return nil, errorx.New(errno.ConversationNotInTrashErrCode)
+	} else if err != nil {
+		logs.Errorf("restore conversation error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationRestoreErrCode)
+	}
+	if err = s.MessageMapper.RestoreByConversation(ctx, req.GetConversationId()); err != nil {
+		logs.Errorf("restore messages error: %s", errorx.ErrorWithoutStack(err))
+		return nil, errorx.WrapByCode(err, errno.ConversationRestoreErrCode)
+	}
+	if err = s.His.Clear(ctx, req.GetConversationId()); err != nil {
+		logs.Errorf("clear history cache error: %s", errorx.ErrorWithoutStack(err))
+	}
+	return &core_api.RestoreConversationResp{Resp: util.Success()}, nil
+}
+
+// PurgeLoop 定时彻底删除超过保留期的对话, 直到ctx结束
+func (s *TrashService) PurgeLoop(ctx context.Context) {
+	c := trashConf()
+	interval := time.Duration(c.PurgeInterval) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.purge(ctx, interval)
+		}
+	}
+}
+
+// purge 执行一轮清理, 每轮最多清理BatchSize个对话, 剩余的留到下一轮
+func (s *TrashService) purge(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if ok, err := s.Cache.SetNX(ctx, purgeLock, 1, timeout).Result(); err != nil {
+		logs.Errorf("lock trash purge error: %s", errorx.ErrorWithoutStack(err))
+		return
+	} else if !ok {
+		return
+	}
+	defer func() { _ = s.Cache.Del(context.WithoutCancel(ctx), purgeLock).Err() }()
+
+	convs, err := s.ConversationMapper.ListExpired(ctx, time.Now().Add(-retention()), int64(trashConf().BatchSize))
+	if err != nil {
+		logs.Errorf("list expired conversation error: %s", errorx.ErrorWithoutStack(err))
+		return
+	}
+	for _, conv := range convs {
+		if err = s.purgeConversation(ctx, conv); err != nil {
+			logs.Errorf("purge conversation %s error: %s", conv.ConversationId.Hex(), errorx.ErrorWithoutStack(err))
+		}
+	}
+}
+
+// purgeConversation 彻底删除对话及其消息、反馈、分享与附件
+// 记忆属于用户且可能由多个对话共同形成, 不随对话删除, 由用户在记忆管理中删除
+// 对话本身最后删除, 中途失败时下一轮会重新清理
+func (s *TrashService) purgeConversation(ctx context.Context, conv *conversation.Conversation) error {
+	msgs, err := s.MessageMapper.FindAllByConversation(ctx, conv.ConversationId)
+	if err != nil {
+		return err
+	}
+	mids := make([]primitive.ObjectID, 0, len(msgs))
+	for _, msg := range msgs {
+		mids = append(mids, msg.MessageId)
+	}
+	if err = s.purgeAttachments(ctx, conv, msgs); err != nil {
+		return err
+	}
+	if err = s.FeedbackMapper.DeleteByMessages(ctx, mids); err != nil {
+		return err
+	}
+	if err = s.ShareMapper.PurgeByConversation(ctx, conv.ConversationId); err != nil {
+		return err
+	}
+	if err = s.MessageMapper.PurgeByConversation(ctx, conv.ConversationId); err != nil {
+		return err
+	}
+	if err = s.His.Clear(ctx, conv.ConversationId.Hex()); err != nil {
+		logs.Errorf("clear history cache error: %s", errorx.ErrorWithoutStack(err))
+	}
+	return s.ConversationMapper.PurgeConversation(ctx, conv.ConversationId)
+}
+
+// purgeAttachments 删除对话中用户上传的附件
+// 分叉与导入的对话会复用原消息的附件, 因此只删除其他对话不再引用且属于该用户的对象
+func (s *TrashService) purgeAttachments(ctx context.Context, conv *conversation.Conversation, msgs []*mmsg.Message) error {
+	var urls []string
+	seen := map[string]bool{}
+	for _, msg := range msgs {
+		for _, u := range msg.AttachmentURLs() {
+			if !seen[u] {
+				seen[u], urls = true, append(urls, u)
+			}
+		}
+	}
+	refs, err := s.MessageMapper.ReferencedURLs(ctx, conv.ConversationId, urls)
+	if err != nil {
+		return err
+	}
+	for _, u := range refs {
+		seen[u] = false
+	}
+	var keys []string
+	prefix := conv.UserId.Hex() + "/"
+	for _, u := range urls {
+		if key, ok := s.Cos.KeyFromURL(u); ok && seen[u] && strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return s.Cos.Delete(ctx, keys...)
+}
+
+func trashConf() *conf.Trash {
+	if c := conf.GetConfig().Trash; c != nil {
+		return c
+	}
+	return &conf.Trash{Retention: 30, PurgeInterval: 3600, BatchSize: 100}
+}
+
+func retention() time.Duration {
+	return time.Duration(trashConf().Retention) * 24 * time.Hour
+}
@@ -254,7 +254,12 @@ func (u *UserService) UpdateProfile(ctx context.Context, req *core_api.BasicUser
 		update[cst.Avatar] = *req.Avatar
 	}
 	if req.Profile != nil {
-		update[cst.Profile] = req.Profile
+		update[cst.Profile] = &user.Profile{
+			Role:          req.Profile.Role,
+			Grade:         req.Profile.Grade,
+			Subject:       req.Profile.Subject,
+			DisableMemory: req.Profile.DisableMemory,
+		}
 	}
 
 	// 一次性更新所有字段
@@ -283,9 +288,10 @@ func (u *UserService) GetProfile(ctx context.Context, req *core_api.BasicUserGet
 
 	if usr.Profile != nil {
 		profile = &core_api.Profile{
-			Role:    usr.Profile.Role,
-			Grade:   usr.Profile.Grade,
-			Subject: usr.Profile.Subject,
+			Role:          usr.Profile.Role,
+			Grade:         usr.Profile.Grade,
+			Subject:       usr.Profile.Subject,
+			DisableMemory: usr.Profile.DisableMemory,
 		}
 	}
 	return &core_api.BasicUserGetProfileResp{
@@ -27,6 +27,11 @@ type Config struct {
 	Cache      *Cache
 	Mongo      *Mongo
 	Bocha      *Bocha
+	Search     *Search     `json:",optional"`
+	Fetch      *Fetch      `json:",optional"`
+	Context    *Context    `json:",optional"`
+	Summary    *Summary    `json:",optional"`
+	UserMemory *UserMemory `json:",optional"`
 	ARK        *ARK
 	Claude     *Claude
 	Coze       *Coze
@@ -34,7 +39,12 @@ type Config struct {
 	Sensitive  *Sensitive
 	Admin      *Admin
 	TitleGen   string
+	Title      *Title   `json:",optional"`
+	Gateway    *Gateway `json:",optional"`
+	Limit      *Limit   `json:",optional"`
 	COS        *COS
+	Export     *Export `json:",optional"`
+	Trash      *Trash  `json:",optional"`
 	CoTea      *CoTea
 	Suggest    *Suggest
 	OCR        *OCR
@@ -18,6 +18,19 @@ type COS struct {
 	SecretKey string
 }
 
+// Export 对话批量导出配置
+type Export struct {
+	URLExpire int `json:",default=86400"` // 导出文件下载链接的有效期, 单位秒
+	Timeout   int `json:",default=600"`   // 批量导出任务的超时时间, 单位秒
+}
+
+// Trash 回收站配置
+type Trash struct {
+	Retention     int `json:",default=30"`   // 删除的对话在回收站中保留的天数, 之后彻底删除
+	PurgeInterval int `json:",default=3600"` // 清理任务的执行间隔, 单位秒
+	BatchSize     int `json:",default=100"`  // 每次清理的对话数上限
+}
+
 type Auth struct {
 	SecretKey    string
 	PublicKey    string
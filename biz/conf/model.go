@@ -5,6 +5,32 @@ type Suggest struct {
 	Template string
 }
 
+// Title 对话标题配置, 配置后在对话中异步生成标题, 并在话题偏移时重新生成
+type Title struct {
+	Model      string `json:",default=InnoSpark"` // 生成标题使用的模型
+	DriftTurns int    `json:",default=6"`         // 距上次生成标题的用户消息数达到该值时检查话题是否偏移, 小于等于0时不检查
+	Template   string `json:",optional"`          // 检查话题偏移的提示词, 为空时使用默认提示词
+}
+
+// Gateway OpenAI兼容接口配置
+type Gateway struct {
+	Models []string `json:",optional"` // 对外开放的模型, 需为已注册的模型, 为空时开放默认的模型
+}
+
+// Limit 限流与token配额配置, 按用户等级生效, 未配置时不限制
+type Limit struct {
+	Tiers map[string]*Quota // key为用户等级, 未设置等级或等级未配置的用户使用default
+}
+
+// Quota 一组限制, 各项为0时不限制
+type Quota struct {
+	Window        int               `json:",default=60"` // 请求限流的滑动窗口, 单位秒
+	Requests      int64             `json:",optional"`   // 窗口内的最大请求数
+	DailyTokens   int64             `json:",optional"`   // 每日token配额
+	MonthlyTokens int64             `json:",optional"`   // 每月token配额
+	Models        map[string]*Quota `json:",optional"`   // 按模型的限制, key为注册的模型名称, 只在用户等级上生效
+}
+
 // InnoSpark 启创配置
 type InnoSpark struct {
 	DefaultBaseURL       string
@@ -22,6 +48,60 @@ type Bocha struct {
 	Template string
 }
 
+// Search 搜索服务配置
+type Search struct {
+	Primary   string `json:",default=bocha"` // 主搜索服务
+	Fallback  string `json:",optional"`      // 备用搜索服务, 主服务失败或无结果时使用
+	CacheTTL  int64  `json:",default=3600"`  // 搜索结果缓存时间(秒), 小于等于0时不缓存
+	LocalPath string `json:",optional"`      // 本地搜索语料路径, jsonl格式
+	Random    bool   `json:",optional"`      // 在排名靠前的网页中随机选取引用, 默认按排名确定性地选取
+
+	Policies map[string]*SearchPolicy `json:",optional"` // 智能体默认搜索策略, key为智能体id
+}
+
+// Context 模型上下文配置
+type Context struct {
+	DefaultLimit   int            `json:",default=32000"` // 未单独配置的模型的上下文token上限
+	Limits         map[string]int `json:",optional"`      // 各模型的上下文token上限, key为注册的模型名称
+	Reserve        int            `json:",default=4096"`  // 为模型输出预留的token数
+	MaxMessages    int            `json:",default=200"`   // 获取历史记录的最大条数
+	CompressTokens int            `json:",default=256"`   // 较早的对话放不下时, 单条消息压缩后的token数
+}
+
+// Summary 对话摘要记忆配置
+type Summary struct {
+	Threshold int    `json:",default=20"`  // 未被摘要覆盖的有效消息数超过该值时生成摘要, 小于等于0时不生成
+	KeepTurns int    `json:",default=4"`   // 生成摘要时保留原文的最近对话轮数
+	MaxTokens int    `json:",default=800"` // 摘要的最大token数
+	Template  string `json:",optional"`    // 摘要提示词, 为空时使用默认提示词
+}
+
+// UserMemory 用户记忆配置
+type UserMemory struct {
+	Disable  bool   `json:",optional"`    // 关闭用户记忆的提取与使用
+	MaxFacts int    `json:",default=100"` // 每个用户最多保存的记忆条数
+	TopK     int    `json:",default=5"`   // 每次对话放入上下文的记忆条数
+	Template string `json:",optional"`    // 记忆提取提示词, 为空时使用默认提示词
+}
+
+// Fetch 网页读取配置
+type Fetch struct {
+	Timeout     int64  `json:",default=10"`           // 单个网页读取超时(秒)
+	MaxSize     int64  `json:",default=2097152"`      // 网页最大读取字节数, 超过时截断
+	ChunkTokens int    `json:",default=2000"`         // 每段正文的token预算
+	AutoTopK    int    `json:",optional"`             // 搜索后自动读取排名前k的网页正文, 为0时不读取
+	UserAgent   string `json:",default=InnoSparkBot"` // 读取网页时使用的UA, 同时用于robots.txt匹配
+}
+
+// SearchPolicy 智能体搜索策略
+type SearchPolicy struct {
+	Freshness string   `json:",optional"` // 默认时间范围
+	Include   []string `json:",optional"` // 允许的站点, 不为空时搜索范围只能在其中
+	Exclude   []string `json:",optional"` // 禁止的站点
+	Count     int      `json:",optional"` // 默认引用数量
+	Random    *bool    `json:",optional"` // 是否随机选取引用, 为空时使用全局配置
+}
+
 // ARK 火山配置
 type ARK struct {
 	Endpoint         string
@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/apikey"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+)
+
+/* 使用API密钥鉴权的请求, 需要校验密钥的模型范围与配额, 并在调用结束后计入用量; 通过JWT鉴权的请求不受影响 */
+
+// Check 校验本次请求的密钥能否调用模型
+func Check(ctx context.Context, model string) error {
+	k := adaptor.ExtractAPIKey(ctx)
+	if k == nil {
+		return nil
+	}
+	if !k.Allow(model) {
+		return errorx.New(errno.APIKeyModelDeniedErrCode, errorx.KV("model", model))
+	}
+	if k.Exhausted() {
+		return errorx.New(errno.APIKeyQuotaExceededErrCode)
+	}
+	return nil
+}
+
+// Allow 本次请求的密钥是否允许调用模型, 用于过滤模型列表
+func Allow(ctx context.Context, model string) bool {
+	k := adaptor.ExtractAPIKey(ctx)
+	return k == nil || k.Allow(model)
+}
+
+// Charge 将本次调用的token数计入密钥的已用量
+func Charge(ctx context.Context, tokens int64) {
+	k := adaptor.ExtractAPIKey(ctx)
+	if k == nil || tokens <= 0 {
+		return
+	}
+	if err := apikey.Mapper.AddUsed(context.WithoutCancel(ctx), k.KeyId, tokens); err != nil {
+		logs.CtxErrorf(ctx, "[apikey] charge key %s err: %s", k.KeyId.Hex(), errorx.ErrorWithoutStack(err))
+	}
+}
+
+// Manageable 密钥的管理只能通过登录鉴权, 避免泄露的密钥创建新的密钥
+func Manageable(ctx context.Context) error {
+	if adaptor.ExtractAPIKey(ctx) != nil {
+		return errorx.New(errno.APIKeyManageDeniedErrCode)
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package export
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+)
+
+/* 对话导出, 将对话当前激活的路径渲染为Markdown、独立的HTML或JSONL */
+
+const (
+	Markdown = "md"
+	HTML     = "html"
+	JSONL    = "jsonl"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// ContentType 导出格式对应的响应类型, 不支持的格式返回空字符串
+func ContentType(format string) string {
+	switch format {
+	case Markdown:
+		return "text/markdown; charset=utf-8"
+	case HTML:
+		return "text/html; charset=utf-8"
+	case JSONL:
+		return "application/x-ndjson; charset=utf-8"
+	}
+	return ""
+}
+
+// FileName 导出文件名, 以对话标题命名, 去除文件名中不允许的字符
+func FileName(c *conversation.Conversation, format string) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) || r < ' ' {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(c.Brief))
+	if name == "" {
+		name = c.ConversationId.Hex()
+	}
+	return name + "." + format
+}
+
+// Render 按格式渲染对话, msgs为按索引倒序的激活路径, 每写完一条消息后刷新一次输出, 以便流式下载
+func Render(w io.Writer, format string, c *conversation.Conversation, msgs []*mmsg.Message) (err error) {
+	var r renderer
+	switch format {
+	case Markdown:
+		r = &markdownRenderer{w: w}
+	case HTML:
+		r = &htmlRenderer{w: w}
+	case JSONL:
+		r = &jsonlRenderer{w: w}
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+	if err = r.begin(c); err != nil {
+		return err
+	}
+	for i := len(msgs) - 1; i >= 0; i-- {
+		if msgs[i].Role != cst.UserEnum && msgs[i].Role != cst.AssistantEnum {
+			continue
+		}
+		if err = r.message(newItem(msgs[i])); err != nil {
+			return err
+		}
+		if f, ok := w.(interface{ Flush() error }); ok {
+			if err = f.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return r.end()
+}
+
+type renderer interface {
+	begin(c *conversation.Conversation) error
+	message(it *item) error
+	end() error
+}
+
+// item 导出的一条消息
+type item struct {
+	Type        string       `json:"type"`
+	MessageId   string       `json:"messageId"`
+	Index       int32        `json:"index"`
+	Role        string       `json:"role"`
+	Content     string       `json:"content"`
+	Think       string       `json:"think,omitempty"`
+	Code        []*mmsg.Code `json:"code,omitempty"`
+	Cite        []*mmsg.Cite `json:"cite,omitempty"`
+	Ocr         string       `json:"ocr,omitempty"`
+	Attachments []string     `json:"attachments,omitempty"`
+	Sensitive   bool         `json:"sensitive,omitempty"`
+	CreateTime  int64        `json:"createTime"`
+	Time        string       `json:"-"`
+}
+
+func newItem(msg *mmsg.Message) *item {
+	it := &item{
+		Type:       "message",
+		MessageId:  msg.MessageId.Hex(),
+		Index:      msg.Index,
+		Role:       mmsg.RoleItoS[msg.Role],
+		Content:    msg.Content,
+		CreateTime: msg.CreateTime.Unix(),
+		Time:       msg.CreateTime.Local().Format(timeLayout),
+	}
+	for _, part := range msg.UserInputMultiContent { // 多模态消息的文本与图片
+		switch part.Type {
+		case mmsg.ChatMessagePartTypeText:
+			it.Content += part.Text
+		case mmsg.ChatMessagePartTypeImageURL:
+			if part.Image != nil && part.Image.URL != nil {
+				it.Attachments = append(it.Attachments, *part.Image.URL)
+			}
+		}
+	}
+	if ext := msg.Ext; ext != nil {
+		it.Think, it.Code, it.Cite, it.Ocr, it.Sensitive = ext.Think, ext.Code, ext.Cite, ext.Ocr, ext.Sensitive
+		for _, a := range ext.AttachInfo {
+			it.Attachments = append(it.Attachments, a.AccessURL)
+		}
+	}
+	return it
+}
+
+func roleName(role string) string {
+	if role == cst.User {
+		return "用户"
+	}
+	return "助手"
+}
+
+// markdownRenderer 渲染为Markdown
+type markdownRenderer struct {
+	w io.Writer
+}
+
+func (r *markdownRenderer) begin(c *conversation.Conversation) error {
+	_, err := fmt.Fprintf(r.w, "# %s\n\n> 创建于 %s, 导出于 %s\n\n", c.Brief, c.CreateTime.Local().Format(timeLayout), time.Now().Format(timeLayout))
+	return err
+}
+
+func (r *markdownRenderer) message(it *item) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n\n*%s*\n\n", roleName(it.Role), it.Time)
+	if it.Think != "" {
+		fmt.Fprintf(&b, "<details>\n<summary>思考过程</summary>\n\n%s\n\n</details>\n\n", it.Think)
+	}
+	if it.Sensitive {
+		b.WriteString("*该回答因包含敏感内容已被隐藏*\n\n")
+	} else if it.Content != "" {
+		b.WriteString(it.Content + "\n\n")
+	}
+	for _, code := range it.Code {
+		fmt.Fprintf(&b, "```%s\n%s\n```\n\n", code.CodeType, code.Code)
+	}
+	for i, url := range it.Attachments {
+		fmt.Fprintf(&b, "- [附件%d](%s)\n", i+1, url)
+	}
+	if len(it.Attachments) > 0 {
+		b.WriteString("\n")
+	}
+	if it.Ocr != "" {
+		fmt.Fprintf(&b, "> 识别文字:\n> %s\n\n", strings.ReplaceAll(it.Ocr, "\n", "\n> "))
+	}
+	if len(it.Cite) > 0 {
+		b.WriteString("参考资料:\n\n")
+		for _, cite := range it.Cite {
+			fmt.Fprintf(&b, "%d. [%s](%s) %s\n", cite.Index, cite.Name, cite.URL, cite.SiteName)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("---\n\n")
+	_, err := io.WriteString(r.w, b.String())
+	return err
+}
+
+func (r *markdownRenderer) end() error {
+	return nil
+}
+
+// htmlRenderer 渲染为不依赖外部资源的独立HTML
+type htmlRenderer struct {
+	w io.Writer
+}
+
+var htmlTemplate = template.Must(template.New("export").Funcs(template.FuncMap{"roleName": roleName}).Parse(`
+{{- define "begin" -}}
+<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Brief}}</title>
+<style>
+body{max-width:860px;margin:0 auto;padding:24px;font-family:-apple-system,"PingFang SC","Microsoft YaHei",sans-serif;line-height:1.7;color:#222}
+.meta{color:#888;font-size:13px}
+.msg{border-radius:8px;padding:12px 16px;margin:16px 0}
+.user{background:#eef4ff}
+.assistant{background:#f7f7f7}
+.role{font-weight:bold}
+.text{white-space:pre-wrap;word-break:break-word}
+details{color:#666;margin:8px 0}
+pre{background:#272822;color:#f8f8f2;padding:12px;border-radius:6px;overflow-x:auto}
+blockquote{border-left:3px solid #ccc;margin:8px 0;padding-left:12px;color:#555;white-space:pre-wrap}
+</style>
+</head>
+<body>
+<h1>{{.Brief}}</h1>
+<p class="meta">创建于 {{.Created}}, 导出于 {{.Exported}}</p>
+{{end -}}
+{{- define "message" -}}
+<div class="msg {{.Role}}">
+<div><span class="role">{{roleName .Role}}</span> <span class="meta">{{.Time}}</span></div>
+{{- if .Think}}
+<details><summary>思考过程</summary><div class="text">{{.Think}}</div></details>
+{{- end}}
+{{- if .Sensitive}}
+<p class="meta">该回答因包含敏感内容已被隐藏</p>
+{{- else if .Content}}
+<div class="text">{{.Content}}</div>
+{{- end}}
+{{- range .Code}}
+<pre><code class="language-{{.CodeType}}">{{.Code}}</code></pre>
+{{- end}}
+{{- range .Attachments}}
+<p>附件: <a href="{{.}}" target="_blank">{{.}}</a></p>
+{{- end}}
+{{- if .Ocr}}
+<blockquote>识别文字:
+{{.Ocr}}</blockquote>
+{{- end}}
+{{- if .Cite}}
+<ol class="meta">
+{{- range .Cite}}
+<li value="{{.Index}}"><a href="{{.URL}}" target="_blank">{{.Name}}</a> {{.SiteName}}</li>
+{{- end}}
+</ol>
+{{- end}}
+</div>
+{{end -}}
+{{- define "end" -}}
+</body>
+</html>
+{{end -}}
+`))
+
+func (r *htmlRenderer) begin(c *conversation.Conversation) error {
+	return htmlTemplate.ExecuteTemplate(r.w, "begin", map[string]string{
+		"Brief":    c.Brief,
+		"Created":  c.CreateTime.Local().Format(timeLayout),
+		"Exported": time.Now().Format(timeLayout),
+	})
+}
+
+func (r *htmlRenderer) message(it *item) error {
+	return htmlTemplate.ExecuteTemplate(r.w, "message", it)
+}
+
+func (r *htmlRenderer) end() error {
+	return htmlTemplate.ExecuteTemplate(r.w, "end", nil)
+}
+
+// jsonlRenderer 渲染为JSONL, 第一行为对话信息, 之后每行一条消息
+type jsonlRenderer struct {
+	w io.Writer
+}
+
+func (r *jsonlRenderer) begin(c *conversation.Conversation) error {
+	return r.line(map[string]any{
+		"type":           "conversation",
+		"conversationId": c.ConversationId.Hex(),
+		"brief":          c.Brief,
+		"botId":          c.BotId,
+		"createTime":     c.CreateTime.Unix(),
+		"updateTime":     c.UpdateTime.Unix(),
+	})
+}
+
+func (r *jsonlRenderer) message(it *item) error {
+	return r.line(it)
+}
+
+func (r *jsonlRenderer) end() error {
+	return nil
+}
+
+func (r *jsonlRenderer) line(v any) error {
+	data, err := sonic.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = r.w.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,274 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/* 对话导入, 支持本系统导出的JSONL与OpenAI格式的messages数组 */
+
+const (
+	OpenAI = "openai"
+
+	MaxImportMessages = 2000 // 单次导入的消息数上限
+)
+
+// Imported 解析后的导入内容
+type Imported struct {
+	Brief    string
+	BotId    string
+	Messages []*ImportedMessage
+}
+
+// ImportedMessage 解析后的一条消息
+type ImportedMessage struct {
+	Role       string
+	Content    string
+	Think      string
+	Images     []string // 用户消息中的图片
+	Code       []*mmsg.Code
+	Cite       []*mmsg.Cite
+	Ocr        string
+	CreateTime time.Time
+}
+
+// Texts 消息中需要审核的文本
+func (m *ImportedMessage) Texts() []string {
+	texts := []string{m.Content, m.Think, m.Ocr}
+	for _, c := range m.Code {
+		texts = append(texts, c.Code)
+	}
+	for _, c := range m.Cite {
+		texts = append(texts, c.Name, c.Snippet)
+	}
+	return texts
+}
+
+// Parse 按格式解析并校验导入内容, 只保留用户与模型消息
+func Parse(format, data string) (imp *Imported, err error) {
+	switch format {
+	case JSONL:
+		imp, err = parseJSONL(data)
+	case OpenAI:
+		imp, err = parseOpenAI(data)
+	default:
+		return nil, fmt.Errorf("不支持的导入格式 %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(imp.Messages) == 0 {
+		return nil, fmt.Errorf("没有可导入的消息")
+	} else if len(imp.Messages) > MaxImportMessages {
+		return nil, fmt.Errorf("消息数超过上限 %d", MaxImportMessages)
+	}
+	return imp, nil
+}
+
+// parseJSONL 解析导出的JSONL, 第一行可以是对话信息, 之后每行一条消息
+func parseJSONL(data string) (*Imported, error) {
+	imp := &Imported{}
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var it struct {
+			item
+			Brief string `json:"brief"`
+			BotId string `json:"botId"`
+		}
+		if err := sonic.UnmarshalString(text, &it); err != nil {
+			return nil, fmt.Errorf("第%d行不是合法的JSON", line)
+		}
+		switch it.Type {
+		case "conversation":
+			imp.Brief, imp.BotId = it.Brief, it.BotId
+			continue
+		case "message", "":
+		default:
+			return nil, fmt.Errorf("第%d行类型 %s 不支持", line, it.Type)
+		}
+		msg, err := newImportedMessage(it.Role, it.Content, it.Attachments)
+		if err != nil {
+			return nil, fmt.Errorf("第%d行%s", line, err.Error())
+		} else if msg == nil {
+			continue
+		}
+		msg.Think, msg.Code, msg.Cite, msg.Ocr = it.Think, it.Code, it.Cite, it.Ocr
+		if it.CreateTime > 0 {
+			msg.CreateTime = time.Unix(it.CreateTime, 0)
+		}
+		imp.Messages = append(imp.Messages, msg)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return imp, nil
+}
+
+// openAIMessage OpenAI格式的消息, content为字符串或多模态内容数组
+type openAIMessage struct {
+	Role             string `json:"role"`
+	Content          any    `json:"content"`
+	ReasoningContent string `json:"reasoning_content"`
+}
+
+// parseOpenAI 解析OpenAI格式的messages数组, 也可以是包含messages字段的请求体
+func parseOpenAI(data string) (*Imported, error) {
+	var msgs []*openAIMessage
+	if data = strings.TrimSpace(data); strings.HasPrefix(data, "{") {
+		var body struct {
+			Messages []*openAIMessage `json:"messages"`
+		}
+		if err := sonic.UnmarshalString(data, &body); err != nil {
+			return nil, fmt.Errorf("不是合法的JSON")
+		}
+		msgs = body.Messages
+	} else if err := sonic.UnmarshalString(data, &msgs); err != nil {
+		return nil, fmt.Errorf("不是合法的JSON")
+	}
+
+	imp := &Imported{}
+	for i, m := range msgs {
+		if m == nil {
+			return nil, fmt.Errorf("第%d条消息为空", i+1)
+		}
+		content, images, err := openAIContent(m.Content)
+		if err != nil {
+			return nil, fmt.Errorf("第%d条消息%s", i+1, err.Error())
+		}
+		msg, err := newImportedMessage(m.Role, content, images)
+		if err != nil {
+			return nil, fmt.Errorf("第%d条消息%s", i+1, err.Error())
+		} else if msg == nil {
+			continue
+		}
+		msg.Think = m.ReasoningContent
+		imp.Messages = append(imp.Messages, msg)
+	}
+	return imp, nil
+}
+
+// openAIContent 解析OpenAI格式的消息内容, 多模态内容只支持文本与图片
+func openAIContent(content any) (text string, images []string, err error) {
+	switch c := content.(type) {
+	case nil:
+		return "", nil, nil
+	case string:
+		return c, nil, nil
+	case []any:
+		var texts []string
+		for _, p := range c {
+			part, ok := p.(map[string]any)
+			if !ok {
+				return "", nil, fmt.Errorf("的内容格式错误")
+			}
+			switch part["type"] {
+			case string(mmsg.ChatMessagePartTypeText):
+				t, _ := part["text"].(string)
+				texts = append(texts, t)
+			case string(mmsg.ChatMessagePartTypeImageURL):
+				img, _ := part["image_url"].(map[string]any)
+				u, _ := img["url"].(string)
+				images = append(images, u)
+			default:
+				return "", nil, fmt.Errorf("的内容类型 %v 不支持", part["type"])
+			}
+		}
+		return strings.Join(texts, "\n"), images, nil
+	}
+	return "", nil, fmt.Errorf("的内容格式错误")
+}
+
+// newImportedMessage 校验角色与内容, 系统与工具消息以及内容为空的模型消息(如导出的违禁或中断消息)不导入, 返回nil
+func newImportedMessage(role, content string, images []string) (*ImportedMessage, error) {
+	switch role {
+	case cst.System, cst.Tool:
+		return nil, nil
+	case cst.User:
+		for _, img := range images {
+			if u, err := url.Parse(img); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+				return nil, fmt.Errorf("的图片地址 %s 不合法", img)
+			}
+		}
+	case cst.Assistant:
+		if len(images) > 0 {
+			return nil, fmt.Errorf("为模型消息, 不能包含图片")
+		}
+	default:
+		return nil, fmt.Errorf("的角色 %s 不支持", role)
+	}
+	if strings.TrimSpace(content) == "" && len(images) == 0 {
+		if role == cst.Assistant {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("内容为空")
+	}
+	return &ImportedMessage{Role: role, Content: content, Images: images}, nil
+}
+
+// ImportMMsgs 将导入的消息构建为存储域消息, 按正序重新编号索引并依次作为上一条消息的子消息, 返回倒序的消息
+func ImportMMsgs(imp *Imported, uid, cid primitive.ObjectID) []*mmsg.Message {
+	now := time.Now()
+	msgs := make([]*mmsg.Message, 0, len(imp.Messages))
+	parent, reply, last := cid, primitive.NilObjectID, time.Time{}
+	for i, im := range imp.Messages {
+		t := im.CreateTime
+		if t.IsZero() {
+			t = now
+		}
+		if !t.After(last) { // 保证创建时间与索引的顺序一致
+			t = last.Add(time.Millisecond)
+		}
+		msg := &mmsg.Message{
+			MessageId:      primitive.NewObjectID(),
+			ConversationId: cid,
+			SectionId:      cid,
+			UserId:         uid,
+			Index:          int32(i),
+			ParentId:       parent,
+			Role:           mmsg.RoleStoI[im.Role],
+			ContentType:    cst.ContentTypeText,
+			MessageType:    cst.MessageTypeText,
+			Content:        im.Content,
+			Ext:            &mmsg.Ext{Brief: im.Content, Think: im.Think, Code: im.Code, Cite: im.Cite, Ocr: im.Ocr},
+			CreateTime:     t,
+			UpdateTime:     now,
+		}
+		if im.Role == cst.User {
+			reply = msg.MessageId
+			if len(im.Images) > 0 { // 有图片时使用多模态内容
+				msg.Content, msg.MessageType = "", cst.MessageTypeMultiple
+				msg.UserInputMultiContent = []*mmsg.MessageInputPart{{Type: mmsg.ChatMessagePartTypeText, Text: im.Content}}
+				for _, img := range im.Images {
+					msg.UserInputMultiContent = append(msg.UserInputMultiContent, &mmsg.MessageInputPart{
+						Type: mmsg.ChatMessagePartTypeImageURL,
+						Image: &mmsg.MessageInputImage{
+							MessagePartCommon: mmsg.MessagePartCommon{URL: &img},
+							Detail:            mmsg.ImageURLDetailAuto,
+						},
+					})
+				}
+			}
+		} else {
+			msg.ReplyId = reply
+		}
+		parent, last = msg.MessageId, t
+		msgs = append(msgs, msg)
+	}
+	for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+		msgs[i], msgs[j] = msgs[j], msgs[i]
+	}
+	return msgs
+}
@@ -0,0 +1,95 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 导出的JSONL可以重新导入, 内容为空的模型消息被跳过
+func TestParseRoundTrip(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cid, img := primitive.NewObjectID(), "https://example.com/a.png"
+	now := time.Unix(time.Now().Unix(), 0)
+	c := &conversation.Conversation{ConversationId: cid, Brief: "标题", BotId: "bot", CreateTime: now, UpdateTime: now}
+	fwd := []*mmsg.Message{
+		{Role: cst.UserEnum, Content: "你好", CreateTime: now},
+		{Role: cst.AssistantEnum, Content: "你好[1]", CreateTime: now.Add(time.Second), Ext: &mmsg.Ext{
+			Think: "思考",
+			Cite:  []*mmsg.Cite{{Index: 1, Name: "网页", URL: "https://example.com", Snippet: "摘要"}},
+			Code:  []*mmsg.Code{{Index: 0, CodeType: "go", Code: "package main"}},
+		}},
+		{Role: cst.UserEnum, CreateTime: now.Add(2 * time.Second), UserInputMultiContent: []*mmsg.MessageInputPart{
+			{Type: mmsg.ChatMessagePartTypeText, Text: "看图"},
+			{Type: mmsg.ChatMessagePartTypeImageURL, Image: &mmsg.MessageInputImage{MessagePartCommon: mmsg.MessagePartCommon{URL: &img}}},
+		}},
+		{Role: cst.AssistantEnum, CreateTime: now.Add(3 * time.Second), Ext: &mmsg.Ext{Sensitive: true}}, // 违禁消息内容为空
+		{Role: cst.SystemEnum, Content: "系统"},
+	}
+	var msgs []*mmsg.Message // 倒序
+	for i := len(fwd) - 1; i >= 0; i-- {
+		fwd[i].MessageId, fwd[i].Index = primitive.NewObjectID(), int32(i)
+		msgs = append(msgs, fwd[i])
+	}
+
+	var sb strings.Builder
+	g.Expect(Render(&sb, JSONL, c, msgs)).To(Succeed())
+	imp, err := Parse(JSONL, sb.String())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(imp.Brief).To(Equal("标题"))
+	g.Expect(imp.BotId).To(Equal("bot"))
+	g.Expect(imp.Messages).To(HaveLen(3))
+	g.Expect(imp.Messages[0]).To(Equal(&ImportedMessage{Role: cst.User, Content: "你好", CreateTime: now}))
+	g.Expect(imp.Messages[1]).To(Equal(&ImportedMessage{Role: cst.Assistant, Content: "你好[1]", Think: "思考",
+		Cite: fwd[1].Ext.Cite, Code: fwd[1].Ext.Code, CreateTime: now.Add(time.Second)}))
+	g.Expect(imp.Messages[2]).To(Equal(&ImportedMessage{Role: cst.User, Content: "看图", Images: []string{img}, CreateTime: now.Add(2 * time.Second)}))
+	g.Expect(imp.Messages[1].Texts()).To(ContainElements("摘要", "网页", "package main", "思考"))
+
+	imported := ImportMMsgs(imp, primitive.NewObjectID(), cid)
+	g.Expect(imported).To(HaveLen(3))
+	g.Expect(imported[0].UserInputMultiContent).To(HaveLen(2))
+	g.Expect(imported[1].ReplyId).To(Equal(imported[2].MessageId))
+	g.Expect(imported[2].ParentId).To(Equal(cid))
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name   string
+		format string
+		data   string
+		roles  []string
+		err    bool
+	}{
+		{"unsupported format", "csv", "a,b", nil, true},
+		{"invalid jsonl", JSONL, "{", nil, true},
+		{"unsupported line type", JSONL, `{"type":"folder"}`, nil, true},
+		{"no message", JSONL, `{"type":"conversation","brief":"b"}`, nil, true},
+		{"empty user", JSONL, `{"role":"user","content":" "}`, nil, true},
+		{"empty assistant skipped", JSONL, `{"role":"user","content":"q"}` + "\n" + `{"role":"assistant","content":""}`, []string{cst.User}, false},
+		{"openai array", OpenAI, `[{"role":"system","content":"s"},{"role":"user","content":"q"},{"role":"assistant","content":"a"}]`, []string{cst.User, cst.Assistant}, false},
+		{"openai body", OpenAI, `{"messages":[{"role":"user","content":[{"type":"text","text":"q"},{"type":"image_url","image_url":{"url":"https://example.com/a.png"}}]}]}`, []string{cst.User}, false},
+		{"openai bad image", OpenAI, `[{"role":"user","content":[{"type":"image_url","image_url":{"url":"file:///etc/passwd"}}]}]`, nil, true},
+		{"openai assistant image", OpenAI, `[{"role":"assistant","content":[{"type":"image_url","image_url":{"url":"https://example.com/a.png"}}]}]`, nil, true},
+		{"openai unknown role", OpenAI, `[{"role":"developer","content":"q"}]`, nil, true},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		imp, err := Parse(c.format, c.data)
+		if c.err {
+			g.Expect(err).To(HaveOccurred(), c.name)
+			continue
+		}
+		g.Expect(err).NotTo(HaveOccurred(), c.name)
+		var roles []string
+		for _, m := range imp.Messages {
+			roles = append(roles, m.Role)
+		}
+		g.Expect(roles).To(Equal(c.roles), c.name)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cloudwego/eino/schema"
 	"github.com/xh-polaris/innospark-core-api/biz/conf"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/interaction"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/memory"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/message"
 	dmodel "github.com/xh-polaris/innospark-core-api/biz/domain/model"
@@ -22,7 +23,8 @@ import (
 	"github.com/xh-polaris/innospark-core-api/pkg/logs"
 )
 
-func DoCompletions(ctx context.Context, st *state.RelayContext, memory *memory.MemoryManager, conv conversation.MongoMapper) (err error) {
+// DoCompletions 执行一轮对话, ticket为限流凭证, 未配置限制时为nil, 用于计入本轮与后台任务的用量
+func DoCompletions(ctx context.Context, st *state.RelayContext, ticket *limit.Ticket, memory *memory.MemoryManager, conv conversation.MongoMapper) (err error) {
 	var history []*mmsg.Message
 
 	ctx = ctxcache.Init(ctx)
@@ -37,12 +39,16 @@ func DoCompletions(ctx context.Context, st *state.RelayContext, memory *memory.M
 	if history, err = DoCompletionOption(st, history); err != nil {
 		return err
 	}
+	// 使用对话摘要替代较早的历史记录
+	history = memory.ApplySummary(ctx, st, history)
 	// 转换存储域消息为模型域消息
 	messages := message.MMsgToEMsgList(history)
 	// 构建模型
 	if messages, err = BuildChatModel(ctx, st, messages); err != nil {
 		return err
 	}
+	// 按模型上下文上限裁剪历史
+	messages = memory.BuildContext(ctx, st, messages)
 	subCtx, cancel := context.WithCancel(ctx)
 	st.CancelFunc = cancel
 
@@ -65,6 +71,9 @@ func DoCompletions(ctx context.Context, st *state.RelayContext, memory *memory.M
 		return nil
 	}
 
+	// 异步生成或更新对话标题
+	titles := StartTitle(ctx, st, ticket, memory, conv)
+
 	var wg sync.WaitGroup
 	var err1, err2 error
 	wg.Add(2)
@@ -77,8 +86,10 @@ func DoCompletions(ctx context.Context, st *state.RelayContext, memory *memory.M
 	// 构建图并执行, 有效数据会通过st.EventStream传递给interaction
 	go func() {
 		defer wg.Done()
-		_, err2 = StreamExecuteFlow(BuildFlow(st), subCtx, messages)
-		logs.CondErrorf(err2 != nil, "execute flow error: %s", err2)
+		if _, err2 = StreamExecuteFlow(BuildFlow(subCtx, st), subCtx, messages); err2 != nil {
+			logs.Errorf("execute flow error: %s", err2)
+			st.EventStream.W.Send(nil, err2) // 图执行失败时结束事件流, 避免交互域一直等待
+		}
 	}()
 	wg.Wait()
 
@@ -103,9 +114,13 @@ func DoCompletions(ctx context.Context, st *state.RelayContext, memory *memory.M
 		wg.Wait()
 	}
 	// 除中断外错误均不存储历史记录
-	if err = memory.StoreHistory(ctx, st); err != nil {
+	if err = memory.StoreHistory(ctx, st, ticket); err != nil {
 		return err
 	}
+	// 标题事件
+	SendTitle(ctx, inter, st, titles)
+	// 计入配额并发送剩余配额
+	SendQuota(ctx, inter, st, ticket)
 	// 结束消息
 	if err = inter.EndEvent(); err != nil {
 		logs.CtxErrorf(ctx, "end event error: %s", err)
@@ -188,6 +203,9 @@ func extract(ctx context.Context, st *state.RelayContext, messages []*schema.Mes
 	if msg, err = m.Generate(ctx, messages); err != nil {
 		return nil, err
 	}
+	if msg.ResponseMeta != nil {
+		st.Info.AddUsage(msg.ResponseMeta.Usage)
+	}
 	// 解析信息
 	jsonStr := util.PurifyJson(msg.Content)
 	if err = sonic.Unmarshal([]byte(jsonStr), &newInfo); err != nil {
@@ -201,3 +219,18 @@ func extract(ctx context.Context, st *state.RelayContext, messages []*schema.Mes
 	}
 	return info, nil
 }
+
+// SendQuota 将本轮用量计入配额, 并发送剩余配额事件; 未执行到这里时由调用方在结束后计入
+func SendQuota(ctx context.Context, inter *interaction.Interaction, st *state.RelayContext, ticket *limit.Ticket) {
+	if ticket == nil {
+		return
+	}
+	usage, _ := st.Info.Usage()
+	qe, err := interaction.QuotaEvent(ticket.Consume(ctx, int64(usage.TotalTokens)))
+	if err != nil {
+		return
+	}
+	if err = inter.SSE.Write(qe.SSEEvent); err != nil {
+		logs.CtxErrorf(ctx, "send quota event error: %s", err)
+	}
+}
@@ -2,46 +2,63 @@ package flow
 
 import (
 	"github.com/xh-polaris/innospark-core-api/biz/domain/interaction"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/message"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
 	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func DoCompletionOption(st *state.RelayContext, his []*mmsg.Message) ([]*mmsg.Message, error) {
 	info, opt := st.Info, st.Info.CompletionOptions
 	opt.Typ = cst.Default
-	// 据自定义对话选项, 对消息进行处理
+	// 据自定义对话选项, 对消息进行处理, 历史记录为当前激活的路径
 	switch {
-	case opt.IsRegen: // 重新生成, 覆盖掉最新的模型输出, 生成regen_list, 不需要增添user message
-		var regen []*mmsg.Message
+	case opt.IsRegen: // 重新生成, 在用户消息下新增一个模型消息分支, 原有的回答设为未激活, 不需要增添user message
 		info.ReplyId = *opt.ReplyId
-		for _, msg := range his { // 将此前同一个replyId且不为空的消息置为空 TODO: 暂时没有覆盖模型的多模态输出(因为没有)
-			if msg.ReplyId.Hex() == *opt.ReplyId && msg.Content != "" {
-				msg.Content = ""
-				regen = append(regen, msg)
-			} else if msg.Role == cst.UserEnum && msg.Content != "" { // 找到的第一个用户消息
-				break
+		for i, msg := range his {
+			if msg.MessageId.Hex() != *opt.ReplyId {
+				continue
 			}
+			if i > 0 { // 用户消息在激活路径上的回答
+				his[i-1].Inactive = true
+				opt.RegenList = append(opt.RegenList, his[i-1])
+			}
+			his = his[i:]
+			break
 		}
-		opt.Typ, opt.RegenList = cst.Regen, regen // 保存regen_list
+		opt.Typ = cst.Regen
 
-	case opt.IsReplace: // 替换最新的一条用户消息, 实际是将最近一轮有效对话设为空且不保留, 需要新的user message
+	case opt.IsReplace: // 替换最新的一条用户消息, 在其父消息下新增一个用户消息分支, 原有的用户消息设为未激活
 		opt.Typ = cst.Replace
 		for _, msg := range his {
-			if msg.Content != "" {
-				msg.Content = ""
-				opt.ReplaceList = append(opt.ReplaceList, msg)
-			}
-			if len(opt.ReplaceList) >= 2 {
+			if msg.Role == cst.UserEnum {
+				his = fork(info, msg)
 				break
 			}
 		}
 
-	case opt.SelectedRegenId != nil: // 选择一个重新生成的结果, 并开始新的对话, 需要增加用户消息
+	case opt.EditMessageId != nil: // 编辑激活路径上任意一条用户消息, 从该处分叉并重新生成, 原有的分支保留
+		opt.Typ = cst.Edit
+		target := editTarget(info, *opt.EditMessageId)
+		if target == nil {
+			return nil, errorx.New(errno.CompletionsEditErrCode)
+		}
+		his = fork(info, target)
+
+	case opt.SelectedRegenId != nil && len(his) > 0: // 选择一个重新生成的结果, 并开始新的对话, 需要增加用户消息
 		opt.Typ = cst.SelectRegen
+		if sel := selectedBranch(info, *opt.SelectedRegenId); sel != nil { // 激活选择的分支
+			opt.SelectRegenList = info.Tree.Activate(sel.MessageId)
+			his = memory.LimitMessages(info.Tree.ActivePath())
+			break
+		}
 		reply := his[0].ReplyId
-		for _, msg := range his { // 只保留一个regen, 其余清空
+		for _, msg := range his { // 旧版本消息没有分支, 只保留一个regen, 其余清空
 			if msg.ReplyId != reply {
 				break
 			}
@@ -54,14 +71,21 @@ func DoCompletionOption(st *state.RelayContext, his []*mmsg.Message) ([]*mmsg.Me
 		}
 	}
 
-	if !opt.IsRegen { // 不是重新生成需要创建用户消息
-		um := message.NewUserMMsg(st, len(his))
+	if !opt.IsRegen { // 不是重新生成需要创建用户消息, 父消息为激活路径上的最新消息
+		um := message.NewUserMMsg(st, nextIndex(info))
+		um.ParentId = info.SectionId
+		if len(his) > 0 {
+			um.ParentId = his[0].MessageId
+		}
+		um.Branch = branchOf(info, um.ParentId)
 		his = append([]*mmsg.Message{um}, his...)
 		info.UserMessage = um
 		info.ReplyId = um.MessageId.Hex()
 	}
 	// 创建模型消息
-	info.MessageInfo.AssistantMessage = message.NewModelMMsg(st, len(his))
+	am := message.NewModelMMsg(st, nextIndex(info))
+	am.Branch = branchOf(info, am.ParentId)
+	info.MessageInfo.AssistantMessage = am
 
 	// 写入元事件
 	if err := st.EventStream.Write(interaction.MetaEvent(
@@ -75,3 +99,57 @@ func DoCompletionOption(st *state.RelayContext, his []*mmsg.Message) ([]*mmsg.Me
 	}
 	return his, nil
 }
+
+// nextIndex 分配新消息的索引, 索引在段落的所有分支中递增, 避免与未激活分支中的消息冲突
+func nextIndex(inf *info.Info) int {
+	index := inf.NextIndex
+	inf.NextIndex++
+	return int(index)
+}
+
+// branchOf 新消息在父消息下的分支序号
+func branchOf(inf *info.Info, parent primitive.ObjectID) int32 {
+	if inf.Tree == nil {
+		return 0
+	}
+	return int32(len(inf.Tree.Children(parent)))
+}
+
+// fork 将用户消息设为未激活, 新的用户消息将作为其兄弟分支, 返回分叉点之前的历史记录
+func fork(inf *info.Info, target *mmsg.Message) []*mmsg.Message {
+	target.Inactive = true
+	inf.CompletionOptions.ReplaceList = append(inf.CompletionOptions.ReplaceList, target)
+	path := inf.Tree.ActivePath() // 分叉点可能早于历史记录的数量上限, 因此从完整路径中截取
+	for i, msg := range path {
+		if msg.Index < target.Index {
+			return memory.LimitMessages(path[i:])
+		}
+	}
+	return nil
+}
+
+// editTarget 编辑的用户消息, 需要在当前激活的路径上
+func editTarget(inf *info.Info, id string) *mmsg.Message {
+	mid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil
+	}
+	for _, msg := range inf.Tree.ActivePath() {
+		if msg.MessageId == mid && msg.Role == cst.UserEnum {
+			return msg
+		}
+	}
+	return nil
+}
+
+// selectedBranch 选择的重新生成结果, 旧版本消息没有分支, 返回nil
+func selectedBranch(inf *info.Info, id string) *mmsg.Message {
+	mid, err := primitive.ObjectIDFromHex(id)
+	if err != nil || inf.Tree == nil {
+		return nil
+	}
+	if msg := inf.Tree.Message(mid); msg != nil && !msg.ParentId.IsZero() {
+		return msg
+	}
+	return nil
+}
@@ -2,13 +2,17 @@ package flow
 
 import (
 	"context"
+	"errors"
+	"io"
 	"strings"
 
 	"github.com/cloudwego/eino/components/prompt"
+	einotool "github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/compose"
 	"github.com/cloudwego/eino/schema"
 	"github.com/xh-polaris/innospark-core-api/biz/conf"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/interaction"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/message"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/message/prompt_inject"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/model"
@@ -16,20 +20,23 @@ import (
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state/event"
 	tool "github.com/xh-polaris/innospark-core-api/biz/domain/tool"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
 	"github.com/xh-polaris/innospark-core-api/pkg/ctxcache"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
 )
 
 type Flow = *compose.Graph[[]*schema.Message, *event.Event]
 
 func StreamExecuteFlow(f Flow, ctx context.Context, input []*schema.Message) (*schema.StreamReader[*event.Event], error) {
-	compiled, err := f.Compile(ctx)
+	compiled, err := f.Compile(ctx, compose.WithMaxRunSteps(MaxRunSteps))
 	if err != nil {
 		return nil, err
 	}
 	return compiled.Stream(ctx, input)
 }
 
-func BuildFlow(st *state.RelayContext) Flow {
+func BuildFlow(ctx context.Context, st *state.RelayContext) Flow {
 	// 初始化状态
 	gls := func(ctx context.Context) (s *state.RelayContext) {
 		v, _ := ctxcache.Get[*state.RelayContext](ctx, cst.CtxState)
@@ -47,38 +54,64 @@ func BuildFlow(st *state.RelayContext) Flow {
 		_ = flow.AddLambdaNode(OCR, ocr, compose.WithNodeName(OCR))
 	}
 
-	// 搜索
+	// 搜索配置, 由模型通过搜索工具决定是否搜索及搜索词
 	if st.Info.ModelInfo.WebSearch {
-		search := compose.InvokableLambda(func(ctx context.Context, input []*schema.Message) (_ []*schema.Message, err error) {
-			return tool.Search(ctx, "bocha", conf.GetConfig().Bocha.APIKey, conf.GetConfig().Bocha.Template, input)
-		})
-		_ = flow.AddLambdaNode(WebSearch, search, compose.WithNodeName(WebSearch))
+		st.Info.ModelInfo.SearchOption = tool.ResolveSearchOption(st.Info.ModelInfo.BotId, st.Info.ModelInfo.SearchOption)
 	}
 
+	// 工具节点, 没有可用工具时不进入工具调用循环
+	tn, infos := buildTools(ctx, st)
+
 	// 模型节点
 	cm := model.NewModelFactory()
-	_ = flow.AddChatModelNode(ChatModel, cm, compose.WithNodeName(ChatModel))
-
-	// 将模型事件写入事件流
-	assembleModelEvents := compose.CollectableLambda(func(ctx context.Context, input *schema.StreamReader[*schema.Message]) (_ *state.RelayContext, err error) {
-		go func() {
-			var m *schema.Message
-			for {
-				m, err = input.Recv()
-				if m != nil {
-					st.Info.MessageInfo.RuntimeAssistantMessage.WriteString(message.GetText(m))
-				}
-				st.EventStream.W.Send(&event.Event{Type: event.ChatModel, Message: m}, err)
-				if err != nil {
-					return
+	if len(infos) > 0 {
+		cm, _ = cm.WithTools(infos)
+	}
+	_ = flow.AddChatModelNode(ChatModel, cm, compose.WithNodeName(ChatModel), compose.WithStatePreHandler(chatModelPreHandler))
+
+	// 将模型事件写入事件流, 并拼接出本轮模型发起的工具调用
+	assembleModelEvents := compose.CollectableLambda(func(ctx context.Context, input *schema.StreamReader[*schema.Message]) (_ *schema.Message, err error) {
+		defer input.Close()
+		var m *schema.Message
+		var calls []*schema.Message
+		var usage *schema.TokenUsage
+		for {
+			if m, err = input.Recv(); err != nil {
+				break
+			}
+			if m.ResponseMeta != nil && m.ResponseMeta.Usage != nil { // 同一轮中以最后返回的用量为准
+				usage = m.ResponseMeta.Usage
+			}
+			if len(m.ToolCalls) > 0 { // 工具调用分片不推送给前端
+				calls = append(calls, &schema.Message{Role: schema.Assistant, ToolCalls: m.ToolCalls})
+				if m.Content == "" {
+					continue
 				}
 			}
-		}()
-		return st, nil
+			st.Info.MessageInfo.RuntimeAssistantMessage.WriteString(message.GetText(m))
+			st.EventStream.W.Send(&event.Event{Type: event.ChatModel, Message: m}, nil)
+		}
+		// 每轮模型调用的用量累加计入, 需在结束事件流之前
+		st.Info.AddModelUsage(usage)
+		if !errors.Is(err, io.EOF) { // 模型异常, 交由交互域结束
+			st.EventStream.W.Send(nil, err)
+			return nil, err
+		}
+		out := schema.AssistantMessage("", nil)
+		if len(calls) > 0 {
+			if out, err = schema.ConcatMessages(calls); err != nil {
+				st.EventStream.W.Send(nil, err)
+				return nil, err
+			}
+		}
+		if !needTools(st, out) && !needFinal(st, out) { // 最终回答, 结束事件流
+			st.EventStream.W.Send(nil, io.EOF)
+		}
+		return out, nil
 	})
 	_ = flow.AddLambdaNode(ChatModelEventSend, assembleModelEvents, compose.WithNodeName(ChatModelEventSend))
 
-	output := compose.StreamableLambda(func(ctx context.Context, st *state.RelayContext) (_ *schema.StreamReader[*event.Event], err error) {
+	output := compose.StreamableLambda(func(ctx context.Context, _ *schema.Message) (_ *schema.StreamReader[*event.Event], err error) {
 		return st.EventStream.R, nil
 	})
 	_ = flow.AddLambdaNode(Output, output, compose.WithNodeName(Output))
@@ -89,26 +122,141 @@ func BuildFlow(st *state.RelayContext) Flow {
 		pre = OCR
 	}
 
-	if st.Info.ModelInfo.WebSearch {
-		_ = flow.AddEdge(pre, WebSearch)
-		pre = WebSearch
-	}
-
 	_ = flow.AddEdge(pre, ChatModel)
 	_ = flow.AddEdge(ChatModel, ChatModelEventSend)
-	_ = flow.AddEdge(ChatModelEventSend, Output)
+	if tn != nil {
+		// 工具执行结果回到模型节点, 直到模型给出最终回答
+		_ = flow.AddToolsNode(Tools, tn, compose.WithNodeName(Tools),
+			compose.WithStatePreHandler(toolsPreHandler), compose.WithStatePostHandler(toolsPostHandler))
+		// 工具调用达到上限后, 不再提供工具, 要求模型根据已有信息给出最终回答
+		final := compose.InvokableLambda(func(ctx context.Context, _ *schema.Message) (_ []*schema.Message, err error) {
+			mi := st.Info.MessageInfo
+			mi.ToolRound++ // 超过上限, 最终回答后不会再进入工具节点
+			mi.Context = append([]*schema.Message{schema.UserMessage(FinalAnswerPrompt)}, mi.Context...)
+			return mi.Context, nil
+		})
+		_ = flow.AddLambdaNode(FinalAnswer, final, compose.WithNodeName(FinalAnswer))
+		_ = flow.AddChatModelNode(FinalChatModel, model.NewModelFactory(), compose.WithNodeName(FinalChatModel))
+		_ = flow.AddBranch(ChatModelEventSend, compose.NewGraphBranch(func(ctx context.Context, in *schema.Message) (string, error) {
+			if needTools(st, in) {
+				return Tools, nil
+			} else if needFinal(st, in) {
+				return FinalAnswer, nil
+			}
+			return Output, nil
+		}, map[string]bool{Tools: true, FinalAnswer: true, Output: true}))
+		_ = flow.AddEdge(Tools, ChatModel)
+		_ = flow.AddEdge(FinalAnswer, FinalChatModel)
+		_ = flow.AddEdge(FinalChatModel, ChatModelEventSend)
+	} else {
+		_ = flow.AddEdge(ChatModelEventSend, Output)
+	}
 	_ = flow.AddEdge(Output, compose.END)
 	return flow
 }
 
 const (
-	WebSearch          = "web-search"
 	ChatModel          = "chat-model"
 	ChatModelEventSend = "chat-model-event-send"
+	Tools              = "tools"
+	FinalAnswer        = "final-answer"
+	FinalChatModel     = "final-chat-model"
 	Output             = "output"
 	OCR                = "ocr"
 )
 
+const (
+	MaxToolRounds = 5                    // 最大工具调用轮次
+	MaxRunSteps   = 3*MaxToolRounds + 13 // 图最大执行步数, 每轮工具调用经过模型/事件/工具三个节点, 达到上限后再经过最终回答的三个节点
+
+	FinalAnswerPrompt = "工具调用次数已达上限, 请不要再调用工具, 直接根据已有信息回答用户的问题。"
+)
+
+// buildTools 根据对话配置构建工具节点及模型可用的工具描述
+func buildTools(ctx context.Context, st *state.RelayContext) (_ *compose.ToolsNode, infos []*schema.ToolInfo) {
+	var tools []einotool.BaseTool
+	if st.Info.ModelInfo.WebSearch { // 联网搜索
+		if search, err := tool.NewSearchTool(ctx, st); err != nil {
+			logs.Errorf("[flow] new search tool err: %s", errorx.ErrorWithoutStack(err))
+		} else {
+			tools = append(tools, tool.WithTemplate(search, st, conf.GetConfig().Bocha.Template))
+		}
+		tools = append(tools, tool.NewFetchTool()) // 读取网页正文
+	}
+	if len(tools) == 0 {
+		return nil, nil
+	}
+	for _, t := range tools {
+		info, err := t.Info(ctx)
+		if err != nil {
+			logs.Errorf("[flow] tool info err: %s", errorx.ErrorWithoutStack(err))
+			return nil, nil
+		}
+		infos = append(infos, info)
+	}
+	tn, err := compose.NewToolNode(ctx, &compose.ToolsNodeConfig{
+		Tools:               tools,
+		UnknownToolsHandler: tool.UnknownTool,                                   // 模型调用了不存在的工具
+		ToolCallMiddlewares: []compose.ToolMiddleware{tool.EventMiddleware(st)}, // 工具调用事件
+	})
+	if err != nil {
+		logs.Errorf("[flow] new tool node err: %s", errorx.ErrorWithoutStack(err))
+		return nil, nil
+	}
+	return tn, infos
+}
+
+// needTools 判断模型消息是否需要进入工具节点
+func needTools(st *state.RelayContext, m *schema.Message) bool {
+	return len(m.ToolCalls) > 0 && st.Info.MessageInfo.ToolRound < MaxToolRounds
+}
+
+// needFinal 判断模型在工具调用达到上限后是否仍要求调用工具, 此时需要强制给出最终回答
+func needFinal(st *state.RelayContext, m *schema.Message) bool {
+	return len(m.ToolCalls) > 0 && st.Info.MessageInfo.ToolRound == MaxToolRounds
+}
+
+// chatModelPreHandler 维护模型上下文, 工具调用后将结果接入上下文再次调用模型
+func chatModelPreHandler(_ context.Context, in []*schema.Message, s *state.RelayContext) ([]*schema.Message, error) {
+	mi := s.Info.MessageInfo
+	if mi.ToolRound == 0 { // 首次调用, 输入即为完整上下文
+		mi.Context = in
+		return in, nil
+	}
+	// 上下文为倒序, 工具结果依次置于最前
+	for _, m := range in {
+		mi.Context = append([]*schema.Message{m}, mi.Context...)
+	}
+	return mi.Context, nil
+}
+
+// toolsPreHandler 记录发起工具调用的模型消息
+func toolsPreHandler(_ context.Context, in *schema.Message, s *state.RelayContext) (*schema.Message, error) {
+	mi := s.Info.MessageInfo
+	mi.ToolRound++
+	mi.Context = append([]*schema.Message{in}, mi.Context...)
+	return in, nil
+}
+
+// toolsPostHandler 记录工具调用结果, 用于存储历史记录
+func toolsPostHandler(_ context.Context, out []*schema.Message, s *state.RelayContext) ([]*schema.Message, error) {
+	mi := s.Info.MessageInfo
+	calls := mi.Context[0].ToolCalls // 工具节点输出顺序与调用顺序一致
+	for i, m := range out {
+		if i >= len(calls) {
+			break
+		}
+		mi.ToolCalls = append(mi.ToolCalls, &mmsg.ToolCall{
+			Id:        m.ToolCallID,
+			Round:     int32(mi.ToolRound),
+			Name:      calls[i].Function.Name,
+			Arguments: calls[i].Function.Arguments,
+			Result:    m.Content,
+		})
+	}
+	return out, nil
+}
+
 // BuildChatModel 构建不同模型
 func BuildChatModel(ctx context.Context, st *state.RelayContext, in []*schema.Message) (_ []*schema.Message, err error) {
 	info := st.Info
@@ -140,6 +288,10 @@ func BuildChatModel(ctx context.Context, st *state.RelayContext, in []*schema.Me
 			return nil, err
 		}
 	}
+	// 检索用户记忆
+	if memory.Memory != nil {
+		in = memory.Memory.RecallFacts(ctx, st, in)
+	}
 	// 写入模型事件
 	if err = st.EventStream.Write(interaction.ModelEvent(
 		info.ModelInfo.Model,
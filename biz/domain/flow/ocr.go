@@ -21,6 +21,7 @@ func DoOCR(ctx context.Context, st *state.RelayContext, baseURL, prompts, key st
 				return nil, err
 			}
 			ocr.WriteString(tmp)
+			st.Info.ModelInfo.OCRCalls++
 		}
 	}
 	st.Info.UserMessage.Ext.Ocr = ocr.String()
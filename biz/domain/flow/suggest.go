@@ -55,12 +55,17 @@ func BuildSuggest(st *state.RelayContext) Suggest {
 	assembleSuggestEvents := compose.CollectableLambda(func(ctx context.Context, input *schema.StreamReader[*schema.Message]) (_ *state.RelayContext, err error) {
 		go func() {
 			var m *schema.Message
+			var usage *schema.TokenUsage
 			for {
-				m, err = input.Recv()
-				st.EventStream.W.Send(&event.Event{Type: event.Suggest, Message: m}, err)
-				if err != nil {
+				if m, err = input.Recv(); err != nil {
+					st.Info.AddUsage(usage) // 需在结束事件流之前计入
+					st.EventStream.W.Send(nil, err)
 					return
 				}
+				if m.ResponseMeta != nil && m.ResponseMeta.Usage != nil {
+					usage = m.ResponseMeta.Usage
+				}
+				st.EventStream.W.Send(&event.Event{Type: event.Suggest, Message: m}, nil)
 			}
 		}()
 		return st, nil
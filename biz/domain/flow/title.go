@@ -0,0 +1,225 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/interaction"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/model"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/ctxcache"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+)
+
+type Title = *compose.Graph[*state.RelayContext, string]
+
+const (
+	TitlePrompt    = "title-prompt"
+	TitleChatModel = "title-chat-model"
+	TitleAssembly  = "title-assembly"
+)
+
+const (
+	titleTimeout      = 30 * time.Second // 生成标题的超时时间
+	titleWait         = 2 * time.Second  // 回答结束后等待标题的最长时间, 超时后标题仍会保存, 由客户端稍后获取
+	titleMessageRunes = 200              // 检查话题偏移时单条用户消息的最大字符数
+	titleMaxRunes     = 30               // 标题的最大字符数
+	titleKeep         = "KEEP"           // 话题没有偏移时模型的输出
+)
+
+const defaultDriftTemplate = `你是对话标题助手, 需要判断对话的话题是否已经偏离当前标题。
+当前标题: {title}
+
+用户最近的提问:
+{history}
+
+如果最近的提问仍然属于当前标题的话题, 只输出 KEEP;
+如果话题已经明显转移, 输出一个概括最近话题的新标题, 不超过15个字, 不要使用引号与标点。`
+
+// titleTask 本轮对话的标题任务, drift为true时检查话题偏移, 否则为首次生成标题
+type titleTask struct {
+	drift   bool
+	title   string          // 当前标题
+	history string          // 用于生成标题的用户提问
+	out     *schema.Message // 模型输出, 用于单独计费
+}
+
+// StartTitle 与回答并行地异步生成或更新对话标题
+// 首轮对话生成标题, 此后每隔DriftTurns条用户消息检查一次话题是否偏移, 偏移时重新生成; 用户重命名过的对话不处理
+// 返回的通道在任务结束后输出更新后的标题, 未更新时直接关闭; 不需要生成标题时返回nil
+// 标题可能在本轮用量计入之后才生成, 因此单独计费
+func StartTitle(ctx context.Context, st *state.RelayContext, ticket *limit.Ticket, mem *memory.MemoryManager, conv conversation.MongoMapper) <-chan string {
+	c := conf.GetConfig().Title
+	if c == nil || st.Info.UserMessage == nil { // 未开启或重新生成回答时不处理
+		return nil
+	}
+	cid := st.Info.ConversationId.Hex()
+	cv, err := conv.GetConversation(ctx, cid)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[flow] get conversation for title err: %s", errorx.ErrorWithoutStack(err))
+		return nil
+	}
+	task := planTitle(st, cv, c)
+	if task == nil {
+		return nil
+	}
+
+	mid, b := st.Info.UserMessage.MessageId, memory.NewBilling(ctx, st.Info, ticket, usage.SourceTitle, TitleModel())
+	titles := make(chan string, 1)
+	go func() {
+		defer close(titles)
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), titleTimeout)
+		defer cancel()
+		compiled, err := BuildTitle(c, task).Compile(ctx)
+		if err != nil {
+			logs.CtxErrorf(ctx, "[flow] compile title err: %s", errorx.ErrorWithoutStack(err))
+			return
+		}
+		start := time.Now()
+		brief, err := compiled.Invoke(ctx, st)
+		mem.Account(ctx, b, task.out, start)
+		if err != nil {
+			logs.CtxErrorf(ctx, "[flow] generate title err: %s", errorx.ErrorWithoutStack(err))
+			return
+		}
+		if brief == task.title { // 标题没有变化时只记录检查位置
+			brief = ""
+		}
+		ok, err := conv.UpdateConversationTitle(ctx, cid, brief, mid)
+		if err != nil {
+			logs.CtxErrorf(ctx, "[flow] update title err: %s", errorx.ErrorWithoutStack(err))
+			return
+		}
+		if ok && brief != "" {
+			titles <- brief
+		}
+	}()
+	return titles
+}
+
+// SendTitle 等待标题任务结束, 标题更新时发送标题事件, 最多等待titleWait, 避免回答结束后长时间占用连接
+func SendTitle(ctx context.Context, inter *interaction.Interaction, st *state.RelayContext, titles <-chan string) {
+	if titles == nil {
+		return
+	}
+	var brief string
+	select {
+	case b, ok := <-titles:
+		if !ok {
+			return
+		}
+		brief = b
+	case <-time.After(titleWait):
+		return
+	case <-ctx.Done():
+		return
+	}
+	te, err := interaction.TitleEvent(st.Info.ConversationId.Hex(), brief)
+	if err != nil {
+		return
+	}
+	if err = inter.SSE.Write(te.SSEEvent); err != nil {
+		logs.CtxErrorf(ctx, "send title event error: %s", err)
+	}
+}
+
+// planTitle 根据对话的标题状态决定本轮的标题任务, 不需要处理时返回nil
+func planTitle(st *state.RelayContext, cv *conversation.Conversation, c *conf.Title) *titleTask {
+	if cv.Renamed {
+		return nil
+	}
+	current := st.Info.OriginMessage.Content
+	if cv.TitleMessageId.IsZero() && cv.Brief == conversation.DefaultBrief { // 首次生成标题
+		return &titleTask{history: current}
+	}
+	if c.DriftTurns <= 0 {
+		return nil
+	}
+	// 统计激活路径上次检查之后的用户消息, 激活路径为倒序, 上次检查的消息不在路径上(新段落或切换分支)时统计整条路径
+	queries := []string{util.TruncateRunes(current, titleMessageRunes)}
+	if st.Info.Tree != nil {
+		for _, msg := range st.Info.Tree.ActivePath() {
+			if msg.MessageId == cv.TitleMessageId {
+				break
+			}
+			if msg.Role == cst.UserEnum && msg.MessageId != st.Info.UserMessage.MessageId && msg.Content != "" {
+				queries = append(queries, util.TruncateRunes(msg.Content, titleMessageRunes))
+			}
+		}
+	}
+	if len(queries) < c.DriftTurns {
+		return nil
+	}
+	queries = queries[:c.DriftTurns]
+	for i, j := 0, len(queries)-1; i < j; i, j = i+1, j-1 { // 整理为正序
+		queries[i], queries[j] = queries[j], queries[i]
+	}
+	return &titleTask{drift: true, title: cv.Brief, history: strings.Join(queries, "\n")}
+}
+
+// BuildTitle 构建标题子图, 依次构建提示词、调用模型并清洗模型输出
+func BuildTitle(c *conf.Title, task *titleTask) Title {
+	gls := func(ctx context.Context) (s *state.RelayContext) {
+		v, _ := ctxcache.Get[*state.RelayContext](ctx, cst.CtxState)
+		return v
+	}
+	title := compose.NewGraph[*state.RelayContext, string](compose.WithGenLocalState(gls))
+
+	// 构建提示词
+	build := compose.InvokableLambda(func(ctx context.Context, _ *state.RelayContext) (_ []*schema.Message, err error) {
+		if !task.drift {
+			return []*schema.Message{schema.UserMessage(fmt.Sprintf(conf.GetConfig().TitleGen, task.history))}, nil
+		}
+		template := prompt.FromMessages(schema.FString, schema.UserMessage(util.ZeroDefault(c.Template, defaultDriftTemplate)))
+		return template.Format(ctx, map[string]any{"title": task.title, "history": task.history})
+	})
+	_ = title.AddLambdaNode(TitlePrompt, build, compose.WithNodeName(TitlePrompt))
+
+	// 调用模型
+	cm := model.NewModelFactory(model.WithModel(TitleModel()), model.WithBotId("")) // 不使用对话的智能体
+	_ = title.AddChatModelNode(TitleChatModel, cm, compose.WithNodeName(TitleChatModel))
+
+	// 清洗标题, 话题没有偏移时沿用当前标题
+	assemble := compose.InvokableLambda(func(ctx context.Context, out *schema.Message) (string, error) {
+		task.out = out
+		brief := CleanTitle(out.Content)
+		if task.drift && (brief == "" || strings.EqualFold(brief, titleKeep)) {
+			return task.title, nil
+		}
+		return brief, nil
+	})
+	_ = title.AddLambdaNode(TitleAssembly, assemble, compose.WithNodeName(TitleAssembly))
+
+	// 编排
+	_ = title.AddEdge(compose.START, TitlePrompt)
+	_ = title.AddEdge(TitlePrompt, TitleChatModel)
+	_ = title.AddEdge(TitleChatModel, TitleAssembly)
+	_ = title.AddEdge(TitleAssembly, compose.END)
+	return title
+}
+
+// TitleModel 生成标题使用的模型
+func TitleModel() string {
+	if c := conf.GetConfig().Title; c != nil && c.Model != "" {
+		return c.Model
+	}
+	return model.DefaultModel
+}
+
+// CleanTitle 清洗模型生成的标题, 去除引号、括号中的说明与多余的行
+func CleanTitle(s string) string {
+	return util.CleanTitle(s, titleMaxRunes)
+}
@@ -0,0 +1,209 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+)
+
+/* OpenAI兼容接口, 将OpenAI格式的请求转换为模型域的消息, 并将模型输出转换为OpenAI格式 */
+
+// ToMessages 将请求中的消息转换为模型域消息, 只支持system、user与assistant
+func ToMessages(msgs []*Message) (out []*schema.Message, err error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("messages is empty")
+	}
+	for i, m := range msgs {
+		var text string
+		var parts []schema.MessageInputPart
+		if text, parts, err = parseContent(m.Content); err != nil {
+			return nil, fmt.Errorf("messages[%d]: %w", i, err)
+		}
+		switch m.Role {
+		case string(schema.System):
+			out = append(out, schema.SystemMessage(text))
+		case string(schema.User):
+			um := schema.UserMessage(text)
+			if len(parts) > 0 {
+				um.Content, um.UserInputMultiContent = "", parts
+			}
+			out = append(out, um)
+		case string(schema.Assistant):
+			out = append(out, schema.AssistantMessage(text, nil))
+		default:
+			return nil, fmt.Errorf("messages[%d]: unsupported role %q", i, m.Role)
+		}
+	}
+	return out, nil
+}
+
+// parseContent 解析字符串或内容片段数组, 片段中只有文本时合并为字符串
+func parseContent(raw json.RawMessage) (text string, parts []schema.MessageInputPart, err error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil, nil
+	}
+	if raw[0] == '"' {
+		err = json.Unmarshal(raw, &text)
+		return text, nil, err
+	}
+	var cps []*ContentPart
+	if err = json.Unmarshal(raw, &cps); err != nil {
+		return "", nil, errors.New("content must be a string or an array of content parts")
+	}
+	var texts []string
+	var image bool
+	for _, cp := range cps {
+		switch cp.Type {
+		case string(schema.ChatMessagePartTypeText):
+			texts = append(texts, cp.Text)
+			parts = append(parts, schema.MessageInputPart{Type: schema.ChatMessagePartTypeText, Text: cp.Text})
+		case string(schema.ChatMessagePartTypeImageURL):
+			if cp.ImageURL == nil || cp.ImageURL.URL == "" {
+				return "", nil, errors.New("image_url is empty")
+			}
+			image = true
+			parts = append(parts, schema.MessageInputPart{Type: schema.ChatMessagePartTypeImageURL, Image: &schema.MessageInputImage{
+				MessagePartCommon: schema.MessagePartCommon{URL: util.Of(cp.ImageURL.URL)},
+				Detail:            schema.ImageURLDetail(cp.ImageURL.Detail),
+			}})
+		default:
+			return "", nil, fmt.Errorf("unsupported content part type %q", cp.Type)
+		}
+	}
+	if !image {
+		parts = nil
+	}
+	return strings.Join(texts, "\n"), parts, nil
+}
+
+// Texts 消息中的全部文本, 用于敏感词检测与用量估算
+func Texts(msgs []*schema.Message) string {
+	var sb strings.Builder
+	for _, m := range msgs {
+		sb.WriteString(util.GetInputText(m))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// Options 请求中的采样参数
+func (r *ChatCompletionReq) Options() (opts []model.Option) {
+	if r.Temperature != nil {
+		opts = append(opts, model.WithTemperature(*r.Temperature))
+	}
+	if r.TopP != nil {
+		opts = append(opts, model.WithTopP(*r.TopP))
+	}
+	if r.MaxTokens != nil && *r.MaxTokens > 0 {
+		opts = append(opts, model.WithMaxTokens(*r.MaxTokens))
+	}
+	if len(r.Stop) > 0 {
+		opts = append(opts, model.WithStop(r.Stop))
+	}
+	return opts
+}
+
+// codePlaceholder 模型域在正文中标注代码位置的占位符, 如[code:0]
+var codePlaceholder = regexp.MustCompile(`\[code:\d+\]`)
+
+// Collector 汇总模型的流式输出
+// 模型域按cst.EventMessageContentType区分内容, 深度思考转换为reasoning_content, 正文与代码转换为content, 其余(如建议)不输出
+// 代码类型与代码内容重新拼接为Markdown代码块, 正文中的代码占位符去除
+type Collector struct {
+	Content      strings.Builder
+	Reasoning    strings.Builder
+	Usage        *schema.TokenUsage
+	FinishReason string
+
+	code  bool // 是否在代码块中
+	start bool // 代码块刚开始, 需要去除代码开头的换行
+}
+
+// Add 收集一个模型分片, 返回其对应的增量, 没有需要输出的内容时返回nil
+func (c *Collector) Add(msg *schema.Message) *OutMessage {
+	if msg == nil {
+		return nil
+	}
+	if msg.ResponseMeta != nil {
+		if msg.ResponseMeta.Usage != nil {
+			c.Usage = msg.ResponseMeta.Usage
+		}
+		if msg.ResponseMeta.FinishReason != "" {
+			c.FinishReason = msg.ResponseMeta.FinishReason
+		}
+	}
+	if msg.Content == "" {
+		return nil
+	}
+	typ, _ := msg.Extra[cst.EventMessageContentType].(int)
+	switch typ {
+	case cst.EventMessageContentTypeThink:
+		c.Reasoning.WriteString(msg.Content)
+		return &OutMessage{ReasoningContent: msg.Content}
+	case cst.EventMessageContentTypeText:
+		return c.write(c.closeCode() + codePlaceholder.ReplaceAllString(msg.Content, ""))
+	case cst.EventMessageContentTypeCodeType:
+		fence := c.closeCode() + "```" + strings.TrimSpace(msg.Content) + "\n"
+		c.code, c.start = true, true
+		return c.write(fence)
+	case cst.EventMessageContentTypeCode:
+		var fence string
+		if !c.code { // 没有代码类型时直接开始代码块
+			fence, c.code, c.start = "```\n", true, true
+		}
+		code := msg.Content
+		if c.start {
+			code = strings.TrimLeft(code, "\r\n")
+			c.start = code == ""
+		}
+		return c.write(fence + code)
+	}
+	return nil
+}
+
+// Close 结束未闭合的代码块, 在模型输出结束后调用, 返回其对应的增量
+func (c *Collector) Close() *OutMessage {
+	return c.write(c.closeCode())
+}
+
+// closeCode 在代码块中时返回闭合代码块的内容
+func (c *Collector) closeCode() string {
+	if !c.code {
+		return ""
+	}
+	c.code, c.start = false, false
+	if strings.HasSuffix(c.Content.String(), "\n") {
+		return "```\n"
+	}
+	return "\n```\n"
+}
+
+func (c *Collector) write(s string) *OutMessage {
+	if s == "" {
+		return nil
+	}
+	c.Content.WriteString(s)
+	return &OutMessage{Content: s}
+}
+
+// Finish 结束原因, 模型未返回时为stop
+func (c *Collector) Finish() *string {
+	return util.Of(util.ZeroDefault(c.FinishReason, FinishStop))
+}
+
+// UsageOf 本次调用的用量, 模型没有返回用量时按输入与输出文本估算
+func (c *Collector) UsageOf(in []*schema.Message) *Usage {
+	if u := c.Usage; u != nil && u.TotalTokens > 0 {
+		return &Usage{PromptTokens: u.PromptTokens, CompletionTokens: u.CompletionTokens, TotalTokens: u.TotalTokens}
+	}
+	prompt := util.EstimateTokens(Texts(in))
+	completion := util.EstimateTokens(c.Reasoning.String()) + util.EstimateTokens(c.Content.String())
+	return &Usage{PromptTokens: prompt, CompletionTokens: completion, TotalTokens: prompt + completion}
+}
@@ -0,0 +1,67 @@
+package gateway
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+)
+
+type chunk struct {
+	typ     int
+	content string
+}
+
+func TestCollector(t *testing.T) {
+	text, think, codeType, code, suggest := cst.EventMessageContentTypeText, cst.EventMessageContentTypeThink,
+		cst.EventMessageContentTypeCodeType, cst.EventMessageContentTypeCode, cst.EventMessageContentTypeSuggest
+	cases := []struct {
+		name      string
+		chunks    []chunk
+		content   string
+		reasoning string
+	}{
+		{"text", []chunk{{text, "你好"}, {text, "世界"}}, "你好世界", ""},
+		{"think and suggest", []chunk{{think, "思考"}, {text, "回答"}, {suggest, "建议"}}, "回答", "思考"},
+		{"code block", []chunk{{text, "示例:\n"}, {text, "[code:0]"}, {codeType, "go"}, {code, "\n"}, {code, "package main\n"}, {text, "\n结束"}},
+			"示例:\n```go\npackage main\n```\n\n结束", ""},
+		{"code without newline", []chunk{{text, "[code:0]"}, {codeType, "python\n"}, {code, "print(1)"}, {text, "完成"}},
+			"```python\nprint(1)\n```\n完成", ""},
+		{"unclosed code", []chunk{{text, "[code:0]"}, {codeType, "sh"}, {code, "ls"}}, "```sh\nls\n```\n", ""},
+		{"code without type", []chunk{{code, "\nx := 1"}, {text, "。"}}, "```\nx := 1\n```\n。", ""},
+		{"adjacent blocks", []chunk{{text, "[code:0]"}, {codeType, "go"}, {code, "a"}, {text, "[code:1]"}, {codeType, "js"}, {code, "b"}},
+			"```go\na\n```\n```js\nb\n```\n", ""},
+		{"inline placeholder", []chunk{{text, "见[code:3]下方"}}, "见下方", ""},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		col := &Collector{}
+		var deltas strings.Builder
+		for _, ch := range c.chunks {
+			msg := &schema.Message{Role: schema.Assistant, Content: ch.content, Extra: map[string]any{cst.EventMessageContentType: ch.typ}}
+			if delta := col.Add(msg); delta != nil {
+				deltas.WriteString(delta.Content)
+			}
+		}
+		if delta := col.Close(); delta != nil {
+			deltas.WriteString(delta.Content)
+		}
+		g.Expect(col.Content.String()).To(Equal(c.content), c.name)
+		g.Expect(deltas.String()).To(Equal(c.content), c.name) // 流式增量与汇总一致
+		g.Expect(col.Reasoning.String()).To(Equal(c.reasoning), c.name)
+		g.Expect(col.Close()).To(BeNil(), c.name)
+	}
+}
+
+func TestCollectorMeta(t *testing.T) {
+	g := NewGomegaWithT(t)
+	col := &Collector{}
+	g.Expect(col.Add(nil)).To(BeNil())
+	g.Expect(*col.Finish()).To(Equal(FinishStop))
+	usage := &schema.TokenUsage{PromptTokens: 3, CompletionTokens: 4, TotalTokens: 7}
+	g.Expect(col.Add(&schema.Message{ResponseMeta: &schema.ResponseMeta{Usage: usage, FinishReason: "length"}})).To(BeNil())
+	g.Expect(*col.Finish()).To(Equal("length"))
+	g.Expect(col.UsageOf(nil)).To(Equal(&Usage{PromptTokens: 3, CompletionTokens: 4, TotalTokens: 7}))
+}
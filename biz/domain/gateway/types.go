@@ -0,0 +1,135 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+/* OpenAI兼容接口的请求与响应, 字段与OpenAI Chat Completions保持一致 */
+
+const (
+	ObjectCompletion = "chat.completion"
+	ObjectChunk      = "chat.completion.chunk"
+	ObjectModel      = "model"
+	ObjectList       = "list"
+
+	FinishStop = "stop"
+	DoneData   = "[DONE]" // 流式响应的结束标记
+)
+
+// ChatCompletionReq 对话补全请求
+type ChatCompletionReq struct {
+	Model         string         `json:"model"`
+	Messages      []*Message     `json:"messages"`
+	Stream        bool           `json:"stream,omitempty"`
+	StreamOptions *StreamOptions `json:"stream_options,omitempty"`
+	Temperature   *float32       `json:"temperature,omitempty"`
+	TopP          *float32       `json:"top_p,omitempty"`
+	MaxTokens     *int           `json:"max_tokens,omitempty"`
+	Stop          StringList     `json:"stop,omitempty"`
+	User          string         `json:"user,omitempty"`
+}
+
+type StreamOptions struct {
+	IncludeUsage bool `json:"include_usage"` // 流式响应结束前额外发送一个只包含用量的分片
+}
+
+// Message 请求中的消息, content可以是字符串或内容片段数组
+type Message struct {
+	Role    string          `json:"role"`
+	Content json.RawMessage `json:"content"`
+	Name    string          `json:"name,omitempty"`
+}
+
+// ContentPart 多模态内容片段
+type ContentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *ImageURL `json:"image_url,omitempty"`
+}
+
+type ImageURL struct {
+	URL    string `json:"url"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// StringList 兼容单个字符串与字符串数组
+type StringList []string
+
+func (l *StringList) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return json.Unmarshal(data, (*[]string)(l))
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s != "" {
+		*l = StringList{s}
+	}
+	return nil
+}
+
+// ChatCompletionResp 非流式响应
+type ChatCompletionResp struct {
+	Id      string    `json:"id"`
+	Object  string    `json:"object"`
+	Created int64     `json:"created"`
+	Model   string    `json:"model"`
+	Choices []*Choice `json:"choices"`
+	Usage   *Usage    `json:"usage,omitempty"`
+}
+
+type Choice struct {
+	Index        int         `json:"index"`
+	Message      *OutMessage `json:"message,omitempty"`
+	Delta        *OutMessage `json:"delta,omitempty"`
+	FinishReason *string     `json:"finish_reason"`
+}
+
+// OutMessage 模型输出的消息, 流式响应中为增量
+type OutMessage struct {
+	Role             string `json:"role,omitempty"`
+	Content          string `json:"content"`
+	ReasoningContent string `json:"reasoning_content,omitempty"` // 深度思考内容
+}
+
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// ChatCompletionChunk 流式响应分片
+type ChatCompletionChunk struct {
+	Id      string    `json:"id"`
+	Object  string    `json:"object"`
+	Created int64     `json:"created"`
+	Model   string    `json:"model"`
+	Choices []*Choice `json:"choices"`
+	Usage   *Usage    `json:"usage,omitempty"`
+}
+
+// ModelList 可用模型列表
+type ModelList struct {
+	Object string   `json:"object"`
+	Data   []*Model `json:"data"`
+}
+
+type Model struct {
+	Id      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+}
+
+// ErrorResp 错误响应
+type ErrorResp struct {
+	Error *Error `json:"error"`
+}
+
+type Error struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Code    int32  `json:"code"`
+}
@@ -0,0 +1,115 @@
+package interaction
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+)
+
+const maxCiteMarkerLen = 32 // 引用标记最大长度, 超过时不再视为引用标记
+
+// citeChecker 检查流式回答中的引用标记, 如[1]、[1,2]、【3】
+// 标记可能被拆分在多个分片中, 未闭合的部分会暂存到下一个分片
+// 需要输入完整的回答文本, 包括搜索之前输出的部分, 标记的位置才与回答文本一致
+type citeChecker struct {
+	pending []rune // 尚未确定是否为引用标记的内容
+	offset  int    // pending在回答文本中的起始位置
+}
+
+// feed 输入一段回答文本, 返回其中完整的引用标记, 每个索引对应一个引用事件, 位置相对于完整的回答文本
+func (c *citeChecker) feed(text string) (used []*adaptor.EventCiteUsed) {
+	c.pending = append(c.pending, []rune(text)...)
+	consumed := len(c.pending)
+	for i := 0; i < len(c.pending); i++ {
+		closer, ok := citeCloser(c.pending[i])
+		if !ok {
+			continue
+		}
+		end, complete := scanCiteMarker(c.pending, i+1, closer)
+		if end < 0 { // 不是引用标记
+			continue
+		}
+		if !complete { // 标记未闭合, 等待后续分片
+			consumed = i
+			break
+		}
+		for _, idx := range parseCiteIndexes(string(c.pending[i+1 : end])) {
+			used = append(used, &adaptor.EventCiteUsed{Index: idx, Start: c.offset + i, End: c.offset + end + 1})
+		}
+		i = end
+	}
+	c.offset += consumed
+	c.pending = c.pending[consumed:]
+	return used
+}
+
+// markCite 校验引用标记, 有效时累计引用次数, 无效时记录为幻觉引用
+// 还没有进行过搜索时返回false, 此时的标记不视为引用
+func markCite(si *info.SearchInfo, e *adaptor.EventCiteUsed) bool {
+	si.Lock()
+	defer si.Unlock()
+	if si.Calls == 0 {
+		return false
+	}
+	for _, cite := range si.Cite {
+		if cite.Index == e.Index {
+			cite.Used++
+			e.Valid = true
+			return true
+		}
+	}
+	logs.Warnf("[interaction] invalid cite index: %d", e.Index)
+	for _, v := range si.Invalid {
+		if v == e.Index {
+			return true
+		}
+	}
+	si.Invalid = append(si.Invalid, e.Index)
+	return true
+}
+
+func citeCloser(r rune) (rune, bool) {
+	switch r {
+	case '[':
+		return ']', true
+	case '【':
+		return '】', true
+	}
+	return 0, false
+}
+
+// scanCiteMarker 从start开始查找引用标记的结束位置
+// 返回-1表示不是引用标记, complete为false表示内容不足以判断
+func scanCiteMarker(rs []rune, start int, closer rune) (end int, complete bool) {
+	digit := false
+	for j := start; j < len(rs); j++ {
+		switch r := rs[j]; {
+		case r == closer:
+			if !digit {
+				return -1, true
+			}
+			return j, true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r == ',' || r == '，' || r == '、' || r == ' ':
+		default:
+			return -1, true
+		}
+		if j-start >= maxCiteMarkerLen {
+			return -1, true
+		}
+	}
+	return len(rs), false
+}
+
+func parseCiteIndexes(s string) (indexes []int32) {
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool { return r < '0' || r > '9' }) {
+		if n, err := strconv.Atoi(f); err == nil {
+			indexes = append(indexes, int32(n))
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,109 @@
+package interaction
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+)
+
+func TestParseCiteIndexes(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cases := []struct {
+		in   string
+		want []int32
+	}{
+		{"1", []int32{1}},
+		{"1,2", []int32{1, 2}},
+		{"1， 2、3", []int32{1, 2, 3}},
+		{"", nil},
+		{" ", nil},
+	}
+	for _, c := range cases {
+		g.Expect(parseCiteIndexes(c.in)).To(Equal(c.want), c.in)
+	}
+}
+
+func TestCiteCheckerFeed(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cases := []struct {
+		name   string
+		chunks []string
+		want   []*adaptor.EventCiteUsed
+	}{
+		{"single", []string{"答案[1]。"}, []*adaptor.EventCiteUsed{{Index: 1, Start: 2, End: 5}}},
+		{"multiple", []string{"a[1,2]"}, []*adaptor.EventCiteUsed{{Index: 1, Start: 1, End: 6}, {Index: 2, Start: 1, End: 6}}},
+		{"full width", []string{"【3】"}, []*adaptor.EventCiteUsed{{Index: 3, Start: 0, End: 3}}},
+		{"split", []string{"ab[", "1", "2]c"}, []*adaptor.EventCiteUsed{{Index: 12, Start: 2, End: 6}}},
+		{"not marker", []string{"[a] [] [1a]"}, nil},
+		{"unclosed", []string{"[1"}, nil},
+		{"too long", []string{"[" + strings.Repeat("1", maxCiteMarkerLen+1) + "]"}, nil},
+	}
+	for _, c := range cases {
+		var cc citeChecker
+		var got []*adaptor.EventCiteUsed
+		for _, chunk := range c.chunks {
+			got = append(got, cc.feed(chunk)...)
+		}
+		g.Expect(got).To(Equal(c.want), c.name)
+	}
+}
+
+// 搜索前输出的文本也计入位置
+func TestCiteCheckerOffsetAcrossSearch(t *testing.T) {
+	g := NewGomegaWithT(t)
+	si := &info.SearchInfo{}
+	var cc citeChecker
+	for _, used := range cc.feed("先回答[1]") { // 尚未搜索, 不视为引用
+		g.Expect(markCite(si, used)).To(BeFalse())
+	}
+	si.Calls, si.Cite = 1, []*mmsg.Cite{{Index: 0}}
+	used := cc.feed("再引用[0]")
+	g.Expect(used).To(HaveLen(1))
+	g.Expect(markCite(si, used[0])).To(BeTrue())
+	g.Expect(*used[0]).To(Equal(adaptor.EventCiteUsed{Index: 0, Start: 9, End: 12, Valid: true}))
+	g.Expect(si.Cite[0].Used).To(Equal(int32(1)))
+}
+
+func TestMarkCite(t *testing.T) {
+	g := NewGomegaWithT(t)
+	si := &info.SearchInfo{Calls: 1, Cite: []*mmsg.Cite{{Index: 0}, {Index: 1}}}
+	for _, idx := range []int32{1, 1, 5, 5} {
+		g.Expect(markCite(si, &adaptor.EventCiteUsed{Index: idx})).To(BeTrue())
+	}
+	g.Expect(si.Cite[1].Used).To(Equal(int32(2)))
+	g.Expect(si.Invalid).To(Equal([]int32{5}))
+}
+
+// 搜索工具追加引用的同时交互域校验引用, 需在-race下通过
+func TestMarkCiteConcurrentSearch(t *testing.T) {
+	g := NewGomegaWithT(t)
+	si := &info.SearchInfo{}
+	const n = 100
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { // 模拟搜索工具
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			si.Lock()
+			si.Calls++
+			si.Cite = append(si.Cite, &mmsg.Cite{Index: int32(len(si.Cite))})
+			si.Unlock()
+		}
+	}()
+	go func() { // 模拟交互域
+		defer wg.Done()
+		var cc citeChecker
+		for i := 0; i < n; i++ {
+			for _, used := range cc.feed("[0]") {
+				markCite(si, used)
+			}
+		}
+	}()
+	wg.Wait()
+	g.Expect(si.Cite).To(HaveLen(n))
+}
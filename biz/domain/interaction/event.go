@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudwego/hertz/pkg/protocol/sse"
 	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state/event"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
@@ -101,6 +102,31 @@ func SearchCiteEvent(cite *mmsg.Cite) (*event.Event, error) {
 	return MarshEvent(cst.EventSearchCite, c)
 }
 
+// CiteUsedEvent 引用使用事件, 标识回答中引用标记对应的引用
+func CiteUsedEvent(e *adaptor.EventCiteUsed) (*event.Event, error) {
+	return MarshEvent(cst.EventCiteUsed, e)
+}
+
+// ToolStartEvent 工具调用开始事件
+func ToolStartEvent(callId, name string) (*event.Event, error) {
+	return MarshEvent(cst.EventToolStart, &adaptor.EventTool{CallId: callId, Name: name})
+}
+
+// ToolArgsEvent 工具调用参数事件
+func ToolArgsEvent(callId, name, args string) (*event.Event, error) {
+	return MarshEvent(cst.EventToolArgs, &adaptor.EventTool{CallId: callId, Name: name, Arguments: args})
+}
+
+// ToolResultEvent 工具调用结果事件
+func ToolResultEvent(callId, name, result string) (*event.Event, error) {
+	return MarshEvent(cst.EventToolResult, &adaptor.EventTool{CallId: callId, Name: name, Result: result})
+}
+
+// ToolEndEvent 工具调用结束事件
+func ToolEndEvent(callId, name string) (*event.Event, error) {
+	return MarshEvent(cst.EventToolEnd, &adaptor.EventTool{CallId: callId, Name: name})
+}
+
 // ExtractInfoEvent 表单提取事件
 func ExtractInfoEvent() (*event.Event, error) {
 	return EventWithoutMarshal(cst.EventExtractInfo, []byte(cst.EventNotifyValue)), nil
@@ -111,6 +137,16 @@ func ExtractInfoEndEvent(obj any) (*event.Event, error) {
 	return MarshEvent(cst.EventExtractInfoEnd, obj)
 }
 
+// TitleEvent 对话标题事件
+func TitleEvent(cid, brief string) (*event.Event, error) {
+	return MarshEvent(cst.EventTitle, &adaptor.EventTitle{ConversationId: cid, Brief: brief})
+}
+
+// QuotaEvent 剩余配额事件
+func QuotaEvent(r *limit.Remaining) (*event.Event, error) {
+	return MarshEvent(cst.EventQuota, &adaptor.EventQuota{Requests: r.Requests, DailyTokens: r.DailyTokens, MonthlyTokens: r.MonthlyTokens})
+}
+
 // MarshEvent 序列化一个消息
 func MarshEvent(typ string, obj any) (_ *event.Event, err error) {
 	var data []byte
@@ -31,6 +31,7 @@ type Interaction struct {
 	containers map[int]*strings.Builder // 记录不同类型内容
 	code       []*strings.Builder       // 记录代码内容
 	codeTyp    []string                 // 记录代码类型
+	cite       citeChecker              // 检查回答中的引用
 }
 
 // NewInteraction 创建交互
@@ -128,9 +129,6 @@ func (i *Interaction) sendSuggest() error {
 // handleChatModel 组装模型事件, 将模型消息转换为ChatEvent
 // 同时兼顾消息内容收集和敏感词检测
 func (i *Interaction) handleChatModel(msg *schema.Message) (err error) {
-	if msg.ResponseMeta != nil { // 收集用量信息
-		i.st.Info.ResponseMeta = msg.ResponseMeta
-	}
 	// 精化消息
 	refine := &info.RefineContent{}
 	content, typ := refine.SetContentWithTyp(msg.Content, msg.Extra[cst.EventMessageContentType].(int))
@@ -152,6 +150,21 @@ func (i *Interaction) handleChatModel(msg *schema.Message) (err error) {
 	if err = i.SSE.Write(ce.SSEEvent); err != nil {
 		return Interrupt
 	}
+	// 检查回答中的引用标记, 搜索前输出的文本也需要输入, 以保证标记位置正确
+	if typ == cst.EventMessageContentTypeText {
+		for _, used := range i.cite.feed(content) {
+			if !markCite(inf.SearchInfo, used) {
+				continue
+			}
+			var cue *event.Event
+			if cue, err = CiteUsedEvent(used); err != nil {
+				return
+			}
+			if err = i.SSE.Write(cue.SSEEvent); err != nil {
+				return Interrupt
+			}
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,33 @@
+package interaction
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/event"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+)
+
+func TestHandleEventEndsOnFlowError(t *testing.T) {
+	g := NewGomegaWithT(t)
+	st := &state.RelayContext{Info: &info.Info{MessageInfo: &info.MessageInfo{}}, EventStream: event.NewEventStream()}
+	defer st.Close()
+	i := &Interaction{st: st, event: st.EventStream, containers: map[int]*strings.Builder{
+		cst.EventMessageContentTypeText: {}, cst.EventMessageContentTypeThink: {}, cst.EventMessageContentTypeSuggest: {},
+	}}
+
+	done := make(chan error, 1)
+	go func() { done <- i.HandleEvent(context.Background()) }()
+	flowErr := errors.New("tool failed")
+	st.EventStream.W.Send(nil, flowErr) // 图执行失败时由执行方结束事件流
+
+	var err error
+	g.Eventually(done, time.Second).Should(Receive(&err))
+	g.Expect(err).To(MatchError(flowErr))
+}
@@ -0,0 +1,277 @@
+package limit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/types/errno"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/* 按用户等级的请求限流与token配额, 用户级与模型级的限制同时生效, 剩余量取两者中较小的
+ * 请求数使用redis有序集合实现滑动窗口, token用量按自然日与自然月计数
+ * redis不可用时不限制, 避免影响正常对话 */
+
+const (
+	prefix         = "inno:limit:"
+	requestPrefix  = prefix + "req:"
+	dailyPrefix    = prefix + "day:"
+	monthlyPrefix  = prefix + "month:"
+	dailyExpire    = 48 * time.Hour
+	monthlyExpire  = 32 * 24 * time.Hour
+	Unlimited      = -1
+	HeaderRequests = "X-RateLimit-Remaining-Requests"
+	HeaderDaily    = "X-Quota-Remaining-Daily-Tokens"
+	HeaderMonthly  = "X-Quota-Remaining-Monthly-Tokens"
+	HeaderRetry    = "Retry-After"
+)
+
+// slidingWindow 检查全部窗口均未超限后再记录本次请求
+// KEYS为各窗口的key, ARGV[1]为当前毫秒时间戳, ARGV[2]为本次请求的成员, ARGV[2i+1]与ARGV[2i+2]为第i个窗口的长度(毫秒)与上限
+// 返回{剩余请求数, 0}, 超限时返回{-1, 需等待的毫秒数}
+const slidingWindow = `
+local now = tonumber(ARGV[1])
+local remaining = -1
+for i, key in ipairs(KEYS) do
+	local window = tonumber(ARGV[2 * i + 1])
+	local limit = tonumber(ARGV[2 * i + 2])
+	redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+	local count = redis.call('ZCARD', key)
+	if count >= limit then
+		local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+		return {-1, tonumber(oldest[2]) + window - now}
+	end
+	if remaining < 0 or limit - count - 1 < remaining then
+		remaining = limit - count - 1
+	end
+end
+for i, key in ipairs(KEYS) do
+	redis.call('ZADD', key, now, ARGV[2])
+	redis.call('PEXPIRE', key, tonumber(ARGV[2 * i + 1]))
+end
+return {remaining, 0}
+`
+
+var rdb cache.Cmdable
+
+func Init(c cache.Cmdable) {
+	rdb = c
+}
+
+// Remaining 剩余的请求数与token数, Unlimited表示不限制
+type Remaining struct {
+	Requests      int64
+	DailyTokens   int64
+	MonthlyTokens int64
+}
+
+// Ticket 通过限流的请求凭证, 调用结束后用于计入token用量
+type Ticket struct {
+	Remaining
+	scopes   []*scope
+	consumed bool // 是否已计入用量
+}
+
+// scope 一组生效的限制, 用户级或用户在某个模型上
+type scope struct {
+	name  string // 用于错误提示
+	key   string // redis key的后缀
+	quota *conf.Quota
+}
+
+// Acquire 检查用户本次调用模型是否超出配额与频率限制, 通过时占用一次请求并返回凭证, 未配置限制时返回nil
+// 剩余量写入响应头, 超出频率限制时同时写入Retry-After
+func Acquire(ctx context.Context, c *app.RequestContext, uid, tier, model string) (*Ticket, error) {
+	scopes := scopesOf(conf.GetConfig().Limit, uid, tier, model)
+	if len(scopes) == 0 || rdb == nil {
+		return nil, nil
+	}
+	t := &Ticket{Remaining: Remaining{Requests: Unlimited, DailyTokens: Unlimited, MonthlyTokens: Unlimited}, scopes: scopes}
+	now := time.Now()
+
+	// token配额, 已用尽时不占用请求数
+	used, err := t.used(ctx, now)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[limit] get token usage err: %s", errorx.ErrorWithoutStack(err))
+		return nil, nil
+	}
+	for i, s := range scopes {
+		if s.quota.DailyTokens > 0 && used[2*i] >= s.quota.DailyTokens {
+			return nil, errorx.New(errno.DailyQuotaExceededErrCode, errorx.KV("scope", s.name))
+		}
+		if s.quota.MonthlyTokens > 0 && used[2*i+1] >= s.quota.MonthlyTokens {
+			return nil, errorx.New(errno.MonthlyQuotaExceededErrCode, errorx.KV("scope", s.name))
+		}
+	}
+	t.tokens(used)
+
+	// 请求频率
+	var keys []string
+	args := []any{now.UnixMilli(), primitive.NewObjectID().Hex()}
+	for _, s := range scopes {
+		if s.quota.Requests > 0 {
+			keys = append(keys, requestPrefix+s.key)
+			args = append(args, int64(util.ZeroDefault(s.quota.Window, 60))*1000, s.quota.Requests)
+		}
+	}
+	if len(keys) > 0 {
+		res, err := rdb.Eval(ctx, slidingWindow, keys, args...).Result()
+		if err != nil {
+			logs.CtxErrorf(ctx, "[limit] sliding window err: %s", errorx.ErrorWithoutStack(err))
+			return t, nil
+		}
+		vals, _ := res.([]any)
+		if len(vals) != 2 {
+			logs.CtxErrorf(ctx, "[limit] unexpected sliding window result: %v", res)
+			return t, nil
+		}
+		remaining, _ := vals[0].(int64)
+		if remaining < 0 {
+			wait, _ := vals[1].(int64)
+			retry := strconv.FormatInt((wait+999)/1000, 10)
+			c.Response.Header.Set(HeaderRetry, retry)
+			return nil, errorx.New(errno.RateLimitedErrCode, errorx.KV("retry", retry))
+		}
+		t.Requests = remaining
+	}
+	t.WriteHeader(c)
+	return t, nil
+}
+
+// Consume 将本次调用的token数计入配额, 返回计入后的剩余量, 同一凭证只计入一次
+func (t *Ticket) Consume(ctx context.Context, tokens int64) *Remaining {
+	if t == nil {
+		return nil
+	}
+	if t.consumed || tokens <= 0 || rdb == nil {
+		return &t.Remaining
+	}
+	t.consumed = true
+	used, err := t.incr(ctx, tokens)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[limit] consume tokens err: %s", errorx.ErrorWithoutStack(err))
+		return &t.Remaining
+	}
+	t.tokens(used)
+	return &t.Remaining
+}
+
+// Charge 将对话结束后的后台调用(如摘要与记忆提取)的token数计入配额, 不更新凭证的剩余量
+func (t *Ticket) Charge(ctx context.Context, tokens int64) {
+	if t == nil || tokens <= 0 || rdb == nil {
+		return
+	}
+	if _, err := t.incr(ctx, tokens); err != nil {
+		logs.CtxErrorf(ctx, "[limit] charge tokens err: %s", errorx.ErrorWithoutStack(err))
+	}
+}
+
+// incr 将token数累加到各限制的日、月用量上, 返回累加后的用量
+func (t *Ticket) incr(ctx context.Context, tokens int64) ([]int64, error) {
+	ctx, now := context.WithoutCancel(ctx), time.Now()
+	pipe := rdb.Pipeline()
+	var cmds []cache.IntCmd
+	for _, s := range t.scopes {
+		day, month := tokenKeys(s.key, now)
+		cmds = append(cmds, pipe.IncrBy(ctx, day, tokens), pipe.IncrBy(ctx, month, tokens))
+		pipe.Expire(ctx, day, dailyExpire)
+		pipe.Expire(ctx, month, monthlyExpire)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	used := make([]int64, len(cmds))
+	for i, cmd := range cmds {
+		used[i], _ = cmd.Result()
+	}
+	return used, nil
+}
+
+// WriteHeader 将剩余量写入响应头
+func (t *Ticket) WriteHeader(c *app.RequestContext) {
+	if t == nil {
+		return
+	}
+	c.Response.Header.Set(HeaderRequests, strconv.FormatInt(t.Requests, 10))
+	c.Response.Header.Set(HeaderDaily, strconv.FormatInt(t.DailyTokens, 10))
+	c.Response.Header.Set(HeaderMonthly, strconv.FormatInt(t.MonthlyTokens, 10))
+}
+
+// used 各限制的今日与本月用量, 依次为第i组的今日用量与本月用量
+func (t *Ticket) used(ctx context.Context, now time.Time) ([]int64, error) {
+	pipe := rdb.Pipeline()
+	var cmds []cache.StringCmd
+	for _, s := range t.scopes {
+		day, month := tokenKeys(s.key, now)
+		cmds = append(cmds, pipe.Get(ctx, day), pipe.Get(ctx, month))
+	}
+	// 计数不存在时pipeline返回Nil错误, 按0处理
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, cache.Nil) {
+		return nil, err
+	}
+	used := make([]int64, len(cmds))
+	for i, cmd := range cmds {
+		used[i], _ = cmd.Int64()
+	}
+	return used, nil
+}
+
+// tokens 根据用量更新剩余token数
+func (t *Ticket) tokens(used []int64) {
+	t.DailyTokens, t.MonthlyTokens = Unlimited, Unlimited
+	for i, s := range t.scopes {
+		t.DailyTokens = minRemaining(t.DailyTokens, s.quota.DailyTokens, used[2*i])
+		t.MonthlyTokens = minRemaining(t.MonthlyTokens, s.quota.MonthlyTokens, used[2*i+1])
+	}
+}
+
+// scopesOf 用户等级在模型上生效的限制
+func scopesOf(c *conf.Limit, uid, tier, model string) (scopes []*scope) {
+	if c == nil || len(c.Tiers) == 0 {
+		return nil
+	}
+	q, ok := c.Tiers[util.ZeroDefault(tier, cst.Default)]
+	if !ok {
+		q = c.Tiers[cst.Default]
+	}
+	if q == nil {
+		return nil
+	}
+	if limited(q) {
+		scopes = append(scopes, &scope{name: "全部模型", key: uid, quota: q})
+	}
+	if mq := q.Models[model]; mq != nil && limited(mq) {
+		scopes = append(scopes, &scope{name: model, key: uid + ":" + model, quota: mq})
+	}
+	return scopes
+}
+
+func limited(q *conf.Quota) bool {
+	return q.Requests > 0 || q.DailyTokens > 0 || q.MonthlyTokens > 0
+}
+
+func tokenKeys(key string, now time.Time) (day, month string) {
+	return fmt.Sprintf("%s%s:%s", dailyPrefix, now.Format("20060102"), key), fmt.Sprintf("%s%s:%s", monthlyPrefix, now.Format("200601"), key)
+}
+
+// minRemaining 合并一项限制的剩余量
+func minRemaining(remaining, limit, used int64) int64 {
+	if limit <= 0 {
+		return remaining
+	}
+	r := max(limit-used, 0)
+	if remaining == Unlimited || r < remaining {
+		return r
+	}
+	return remaining
+}
@@ -0,0 +1,78 @@
+package limit
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+)
+
+func TestMinRemaining(t *testing.T) {
+	cases := []struct {
+		name                   string
+		remaining, limit, used int64
+		want                   int64
+	}{
+		{"no limit", Unlimited, 0, 100, Unlimited},
+		{"no limit keeps remaining", 5, 0, 100, 5},
+		{"first limit", Unlimited, 100, 30, 70},
+		{"smaller wins", 50, 100, 30, 50},
+		{"new smaller", 80, 100, 30, 70},
+		{"exhausted", Unlimited, 100, 130, 0},
+		{"exhausted with remaining", 10, 100, 100, 0},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(minRemaining(c.remaining, c.limit, c.used)).To(Equal(c.want), c.name)
+	}
+}
+
+func TestScopesOf(t *testing.T) {
+	model := &conf.Quota{DailyTokens: 10}
+	def := &conf.Quota{Requests: 5, Models: map[string]*conf.Quota{"m": model, "free": {}}}
+	pro := &conf.Quota{MonthlyTokens: 1000}
+	onlyModels := &conf.Quota{Models: map[string]*conf.Quota{"m": model}}
+	c := &conf.Limit{Tiers: map[string]*conf.Quota{"default": def, "pro": pro, "vip": onlyModels}}
+	cases := []struct {
+		name  string
+		c     *conf.Limit
+		tier  string
+		model string
+		keys  []string
+	}{
+		{"no config", nil, "", "m", nil},
+		{"no tiers", &conf.Limit{}, "", "m", nil},
+		{"default tier", c, "", "x", []string{"u"}},
+		{"unknown tier falls back to default", c, "gold", "x", []string{"u"}},
+		{"model scope", c, "", "m", []string{"u", "u:m"}},
+		{"unlimited model", c, "", "free", []string{"u"}},
+		{"tier without model", c, "pro", "m", []string{"u"}},
+		{"model only", c, "vip", "m", []string{"u:m"}},
+		{"nothing limited", c, "vip", "x", nil},
+	}
+	for _, cs := range cases {
+		g := NewGomegaWithT(t)
+		var keys []string
+		for _, s := range scopesOf(cs.c, "u", cs.tier, cs.model) {
+			keys = append(keys, s.key)
+		}
+		g.Expect(keys).To(Equal(cs.keys), cs.name)
+	}
+}
+
+func TestTicketTokens(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tk := &Ticket{scopes: []*scope{
+		{key: "u", quota: &conf.Quota{DailyTokens: 100}},
+		{key: "u:m", quota: &conf.Quota{DailyTokens: 50, MonthlyTokens: 500}},
+	}}
+	tk.tokens([]int64{30, 300, 20, 480})
+	g.Expect(tk.DailyTokens).To(Equal(int64(30)))
+	g.Expect(tk.MonthlyTokens).To(Equal(int64(20)))
+
+	// 没有凭证或redis不可用时不计入
+	var nilTicket *Ticket
+	g.Expect(nilTicket.Consume(t.Context(), 10)).To(BeNil())
+	g.Expect(tk.Consume(t.Context(), 10)).To(Equal(&tk.Remaining))
+	g.Expect(tk.consumed).To(BeFalse())
+}
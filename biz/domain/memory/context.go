@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/window"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/model"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+)
+
+// BuildContext 按模型的上下文上限构建上下文, in为倒序的模型消息
+// 系统提示词、对话摘要与最新一轮对话总是保留, 较早的对话按轮次从新到旧依次放入,
+// 放不下时先压缩该轮中的长消息, 仍放不下则丢弃该轮及更早的对话
+func (*MemoryManager) BuildContext(ctx context.Context, st *state.RelayContext, in []*schema.Message) []*schema.Message {
+	c := model.ContextConf()
+	ci := &info.ContextInfo{Limit: model.ContextLimit(st.Info.ModelInfo.Model)}
+	st.Info.ContextInfo = ci
+	if si := st.Info.SummaryInfo; si != nil && !si.Stale && si.Content != "" {
+		in, ci.Summarized = window.InjectSystem(in, summaryPrefix+si.Content), si.Covered
+	}
+
+	out := window.Build(in, ci, c.Reserve, c.CompressTokens)
+	if ci.Dropped > 0 || ci.Compressed > 0 || ci.Summarized > 0 || ci.Tokens > ci.Limit-c.Reserve {
+		logs.CtxInfof(ctx, "[memory] build context model=%s limit=%d tokens=%d dropped=%d compressed=%d summarized=%d",
+			st.Info.ModelInfo.Model, ci.Limit, ci.Tokens, ci.Dropped, ci.Compressed, ci.Summarized)
+	}
+	return out
+}
@@ -0,0 +1,230 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/window"
+	dmodel "github.com/xh-polaris/innospark-core-api/biz/domain/model"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	umem "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/memory"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/xh-polaris/innospark-core-api/pkg/segment"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/* 用户记忆, 从对话中提取关于用户本人的长期信息, 跨对话使用 */
+
+const (
+	factTimeout      = time.Minute // 单次提取记忆的超时时间
+	factAnswerTokens = 500         // 提取记忆时参考的模型回答的最大token数
+	factPrefix       = "以下是此前对话中了解到的用户信息, 回答时可结合参考, 无关时忽略, 不要主动提及:\n"
+)
+
+const defaultFactTemplate = `你是用户记忆助手, 需要从最新一轮对话中提取关于用户本人的长期信息, 并与已有记忆合并。
+要求:
+1. 只记录用户本人明确陈述且长期有效的信息, 如身份、年级、学科、教材版本、薄弱知识点、考试时间、学习目标与偏好
+2. 不记录一次性的提问内容、助手的回答以及对用户的推测
+3. 与已有记忆重复时不要新增; 与已有记忆冲突或更具体时更新对应记忆; 用户明确否认的记忆需要删除
+4. 每条记忆为一句简短的陈述, 如"用户使用人教版数学教材"
+5. 只输出JSON, 格式为{{"add": ["新增记忆"], "update": [{{"id": 1, "content": "更新后的记忆"}}], "delete": [2]}}, id为已有记忆的序号, 没有变化时各字段为空数组
+
+已有记忆:
+{facts}
+
+最新一轮对话:
+用户: {query}
+助手: {answer}`
+
+// factOps 记忆提取结果, id为已有记忆的序号, 从1开始
+type factOps struct {
+	Add    []string `json:"add"`
+	Update []struct {
+		Id      int    `json:"id"`
+		Content string `json:"content"`
+	} `json:"update"`
+	Delete []int `json:"delete"`
+}
+
+// factLocks 同一用户的记忆提取串行执行, 避免重复新增; 按用户id分段加锁, 锁的数量固定
+var factLocks [64]sync.Mutex
+
+func factLock(uid string) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(uid))
+	return &factLocks[h.Sum32()%uint32(len(factLocks))]
+}
+
+// ExtractFacts 对话结束后异步从最新一轮对话中提取用户记忆
+// 用户关闭记忆、重新生成或触发违禁词时不提取
+func (m *MemoryManager) ExtractFacts(ctx context.Context, st *state.RelayContext, ticket *limit.Ticket) {
+	c, inf := factConf(), st.Info
+	if c.Disable || inf.Profile.MemoryDisabled() || inf.UserMessage == nil || len(inf.Sensitive.Hits) > 0 {
+		return
+	}
+	query := strings.TrimSpace(inf.OriginMessage.Content)
+	if query == "" {
+		return
+	}
+	uid, cid, answer := inf.UserId.Hex(), inf.ConversationId, window.TruncateTokens(inf.MessageInfo.Text, factAnswerTokens)
+	b := NewBilling(ctx, inf, ticket, usage.SourceMemory, dmodel.DoubaoFlash)
+	go func() {
+		l := factLock(uid)
+		l.Lock()
+		defer l.Unlock()
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), factTimeout)
+		defer cancel()
+		if err := m.extractFacts(ctx, c, b, uid, cid, query, answer); err != nil {
+			logs.Errorf("[memory] extract facts of user %s err: %s", uid, errorx.ErrorWithoutStack(err))
+		}
+	}()
+}
+
+func (m *MemoryManager) extractFacts(ctx context.Context, c *conf.UserMemory, b *Billing, uid string, cid primitive.ObjectID, query, answer string) (err error) {
+	facts, err := m.fact.ListAllMemories(ctx, uid, int64(c.MaxFacts))
+	if err != nil {
+		return err
+	}
+	lines := make([]string, len(facts))
+	for i, f := range facts {
+		lines[i] = fmt.Sprintf("%d. %s", i+1, f.Content)
+	}
+	in, err := prompt.FromMessages(schema.FString, schema.UserMessage(util.ZeroDefault(c.Template, defaultFactTemplate))).Format(ctx,
+		map[string]any{"facts": util.ZeroDefault(strings.Join(lines, "\n"), "无"), "query": query, "answer": answer})
+	if err != nil {
+		return err
+	}
+	cm, err := dmodel.NewDoubaoFlashChatModel(ctx, uid, "")
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	out, err := cm.Generate(ctx, in)
+	if err != nil {
+		return err
+	}
+	m.Account(ctx, b, out, start)
+	var ops factOps
+	if err = sonic.Unmarshal([]byte(util.PurifyJson(out.Content)), &ops); err != nil {
+		logs.Errorf("[memory] unmarshal facts %s err: %s", out.Content, err)
+		return nil
+	}
+
+	// 已有记忆按规范化内容去重
+	seen := make(map[string]bool, len(facts))
+	for _, f := range facts {
+		seen[normalizeFact(f.Content)] = true
+	}
+	deleted := map[int]bool{}
+	var mids []string
+	for _, id := range ops.Delete {
+		if id >= 1 && id <= len(facts) && !deleted[id] {
+			deleted[id] = true
+			mids = append(mids, facts[id-1].MemoryId.Hex())
+		}
+	}
+	if len(mids) > 0 {
+		if err = m.fact.DeleteMemory(ctx, uid, mids); err != nil {
+			return err
+		}
+	}
+	for _, u := range ops.Update {
+		content := strings.TrimSpace(u.Content)
+		if u.Id < 1 || u.Id > len(facts) || deleted[u.Id] || content == "" || seen[normalizeFact(content)] {
+			continue
+		}
+		seen[normalizeFact(content)] = true
+		if err = m.fact.UpdateContent(ctx, facts[u.Id-1].MemoryId, cid, content); err != nil {
+			return err
+		}
+	}
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return err
+	}
+	count := len(facts) - len(mids)
+	for _, content := range ops.Add {
+		if content = strings.TrimSpace(content); content == "" || seen[normalizeFact(content)] {
+			continue
+		}
+		if count >= c.MaxFacts {
+			logs.Warnf("[memory] facts of user %s reach limit %d", uid, c.MaxFacts)
+			break
+		}
+		seen[normalizeFact(content)] = true
+		if err = m.fact.Insert(ctx, &umem.Memory{UserId: oid, Content: content, ConversationId: cid}); err != nil {
+			return err
+		}
+		count++
+	}
+	return nil
+}
+
+// RecallFacts 按与用户提问的相关程度检索用户记忆, 作为系统消息放入上下文, in为倒序的模型消息
+func (m *MemoryManager) RecallFacts(ctx context.Context, st *state.RelayContext, in []*schema.Message) []*schema.Message {
+	c := factConf()
+	if c.Disable || c.TopK <= 0 || st.Info.Profile.MemoryDisabled() {
+		return in
+	}
+	facts, err := m.fact.ListAllMemories(ctx, st.Info.UserId.Hex(), int64(c.MaxFacts))
+	if err != nil {
+		logs.CtxErrorf(ctx, "[memory] list facts err: %s", errorx.ErrorWithoutStack(err))
+		return in
+	}
+	if len(facts) == 0 {
+		return in
+	}
+	facts = rankFacts(facts, st.Info.OriginMessage.Content, c.TopK)
+	lines := make([]string, len(facts))
+	for i, f := range facts {
+		lines[i] = "- " + f.Content
+	}
+	return window.InjectSystem(in, factPrefix+strings.Join(lines, "\n"))
+}
+
+// rankFacts 按与提问的词项重合数排序, 重合数相同时保持最近更新的在前, 取前k条
+func rankFacts(facts []*umem.Memory, query string, k int) []*umem.Memory {
+	terms := segment.QueryTerms(query)
+	scores := make(map[*umem.Memory]int, len(facts))
+	for _, f := range facts {
+		set := segment.TermSet(f.Content)
+		for _, t := range terms {
+			if set[t] {
+				scores[f]++
+			}
+		}
+	}
+	sort.SliceStable(facts, func(i, j int) bool { return scores[facts[i]] > scores[facts[j]] })
+	return facts[:min(k, len(facts))]
+}
+
+// normalizeFact 去除空白与标点, 用于记忆去重
+func normalizeFact(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
+
+func factConf() *conf.UserMemory {
+	if c := conf.GetConfig().UserMemory; c != nil {
+		return c
+	}
+	return &conf.UserMemory{MaxFacts: 100, TopK: 5}
+}
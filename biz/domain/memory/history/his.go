@@ -33,29 +33,42 @@ func New(cache cache.Cmdable, mapper message.MongoMapper) *HistoryManager {
 	return Mgr
 }
 
-// RetrieveMessage 获取消息, size 小于等于0时取出所有
+// RetrieveMessage 获取段落中当前激活分支上的消息, size 小于等于0时取出所有
+func (h *HistoryManager) RetrieveMessage(ctx context.Context, id, section string, size int) (msgs []*message.Message, err error) {
+	tree, err := h.RetrieveTree(ctx, id, section)
+	if err != nil {
+		return nil, err
+	}
+	if msgs = tree.ActivePath(); size > 0 && len(msgs) > size {
+		return msgs[:size], nil
+	}
+	return msgs, nil
+}
+
+// RetrieveTree 获取段落中所有分支的消息构成的消息树
 // 首先从缓存中获取, 获取失败时从数据库中获取, 后重新构建缓存
-func (h *HistoryManager) RetrieveMessage(ctx context.Context, id string, size int) (msgs []*message.Message, err error) {
+func (h *HistoryManager) RetrieveTree(ctx context.Context, id, section string) (_ *message.Tree, err error) {
 	// retrieve cache
-	if msgs, err = h.RetrieveMessagesFromCache(ctx, id, size); err == nil {
-		return msgs, nil
+	msgs, err := h.RetrieveMessagesFromCache(ctx, id, section, 0)
+	if err == nil {
+		return message.NewTree(msgs), nil
 	}
-	// retrieve storage
-	if msgs, err = h.mapper.RetrieveMessages(ctx, id, size); err != nil {
+	// retrieve storage, 分支需要完整的消息才能还原, 因此取出段落中所有消息
+	if msgs, err = h.mapper.RetrieveMessages(ctx, id, section, 0); err != nil {
 		return nil, err
 	}
-	// rebuild cache
+	// rebuild cache, 缓存中只保留所取段落的消息
 	if len(msgs) > 0 {
 		if err = h.CacheMessages(ctx, id, msgs, true); err != nil {
 			logs.Errorf("cache msgs err: %s", err)
 		}
 	}
-	return msgs, nil
+	return message.NewTree(msgs), nil
 }
 
-// RetrieveMessagesFromCache 从缓存中获取一批消息
-// 缓存中找不到时返回cache.Nil, 否则返回size指定的数量
-func (h *HistoryManager) RetrieveMessagesFromCache(ctx context.Context, id string, size int) ([]*message.Message, error) {
+// RetrieveMessagesFromCache 从缓存中获取一批段落中的消息
+// 缓存中找不到该段落的消息时返回cache.Nil, 否则返回size指定的数量
+func (h *HistoryManager) RetrieveMessagesFromCache(ctx context.Context, id, section string, size int) ([]*message.Message, error) {
 	result, err := h.cache.HGetAll(ctx, key(id)).Result()
 	if err != nil {
 		return nil, err
@@ -63,21 +76,22 @@ func (h *HistoryManager) RetrieveMessagesFromCache(ctx context.Context, id strin
 		return nil, cache.Nil
 	}
 
-	msgs := make([]*message.Message, len(result), len(result))
-	i := 0
+	msgs := make([]*message.Message, 0, len(result))
 	for _, data := range result {
 		var msg message.Message
 		if err = sonic.Unmarshal([]byte(data), &msg); err != nil {
 			logs.Errorf("[message mapper] listAllMsg: json.Unmarshal err:%s", errorx.ErrorWithoutStack(err))
 			return nil, err
 		}
-		msgs[i] = &msg
-		i++
+		if section == "" || msg.SectionId.Hex() == section {
+			msgs = append(msgs, &msg)
+		}
 	}
-
-	if len(msgs) > 0 {
-		sort.Slice(msgs, func(i, j int) bool { return msgs[i].Index > msgs[j].Index }) // 倒序
+	if len(msgs) == 0 { // 缓存的是其他段落
+		return nil, cache.Nil
 	}
+
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].Index > msgs[j].Index }) // 倒序
 	if size > 0 && len(msgs) > size {
 		return msgs[:size], nil
 	}
@@ -136,6 +150,18 @@ func (h *HistoryManager) UpdateMessages(ctx context.Context, msgs []*message.Mes
 	return
 }
 
+// Fork 分叉时在同一事务中更新旧分支的消息并新增消息, 然后删除缓存
+func (h *HistoryManager) Fork(ctx context.Context, update, insert []*message.Message) (err error) {
+	if err = h.mapper.Fork(ctx, update, insert); err != nil {
+		logs.Errorf("fork message err: %s", err)
+		return
+	}
+	if err = h.cache.Del(ctx, keyFromMsg(insert[0])).Err(); err != nil {
+		logs.Errorf("delete cache err: %s", err)
+	}
+	return
+}
+
 // Feedback 对消息进行反馈, 由于涉及到删除消息所以此处处理
 func (h *HistoryManager) Feedback(ctx context.Context, mid primitive.ObjectID, feedback int32) (err error) {
 	var msg *message.Message
@@ -152,6 +178,30 @@ func (h *HistoryManager) Feedback(ctx context.Context, mid primitive.ObjectID, f
 	return
 }
 
+// ListBranch 获取消息及其兄弟分支, 按索引正序
+func (h *HistoryManager) ListBranch(ctx context.Context, msg *message.Message) ([]*message.Message, error) {
+	tree, err := h.RetrieveTree(ctx, msg.ConversationId.Hex(), msg.SectionId.Hex())
+	if err != nil {
+		return nil, err
+	}
+	return tree.Siblings(msg.MessageId), nil
+}
+
+// SwitchBranch 将消息所在的分支设为激活, 同组的其他分支设为未激活
+// 消息下的子分支保持此前的选择, 因此切换回来时还原为原先的路径
+func (h *HistoryManager) SwitchBranch(ctx context.Context, msg *message.Message) (err error) {
+	tree, err := h.RetrieveTree(ctx, msg.ConversationId.Hex(), msg.SectionId.Hex())
+	if err != nil {
+		return err
+	}
+	return h.UpdateMessages(ctx, tree.Activate(msg.MessageId))
+}
+
+// Clear 清除对话的历史记录缓存, 下次读取时从数据库重建
+func (h *HistoryManager) Clear(ctx context.Context, id string) (err error) {
+	return h.cache.Del(ctx, key(id)).Err()
+}
+
 func key(id string) string {
 	return cachePrefix + id
 }
@@ -3,58 +3,129 @@ package memory
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/adaptor"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/apikey"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/history"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/model"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	umem "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/memory"
 	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
 	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
 	"github.com/xh-polaris/innospark-core-api/pkg/logs"
 )
 
 var Memory *MemoryManager
 
-// MemoryManager 管理大模型记忆
+// MemoryManager 管理大模型记忆, 包括历史记录、对话摘要与用户记忆
 type MemoryManager struct {
-	his *history.HistoryManager
+	his   *history.HistoryManager
+	conv  conversation.MongoMapper
+	fact  umem.MongoMapper
+	usage usage.MongoMapper
 }
 
-func New(his *history.HistoryManager) *MemoryManager {
-	Memory = &MemoryManager{his: his}
+func New(his *history.HistoryManager, conv conversation.MongoMapper, fact umem.MongoMapper, usage usage.MongoMapper) *MemoryManager {
+	Memory = &MemoryManager{his: his, conv: conv, fact: fact, usage: usage}
 	return Memory
 }
 
+// Billing 后台模型调用的计费信息, 在对话结束前从状态中取出, 避免后台任务读取状态
+// 摘要、用户记忆与标题在对话结束后才完成, 用量不计入本轮对话, 单独计费并记录流水
+type Billing struct {
+	ticket *limit.Ticket
+	record *usage.Record
+}
+
+func NewBilling(ctx context.Context, inf *info.Info, ticket *limit.Ticket, source, mo string) *Billing {
+	r := &usage.Record{UserId: inf.UserId, ConversationId: inf.ConversationId, Source: source, Model: mo}
+	if k := adaptor.ExtractAPIKey(ctx); k != nil {
+		r.KeyId = k.KeyId
+	}
+	return &Billing{ticket: ticket, record: r}
+}
+
+// Account 将后台模型调用的用量计入用户配额与API密钥, 并记录用量流水
+func (m *MemoryManager) Account(ctx context.Context, b *Billing, out *schema.Message, start time.Time) {
+	if out == nil || out.ResponseMeta == nil || out.ResponseMeta.Usage == nil || out.ResponseMeta.Usage.TotalTokens <= 0 {
+		return
+	}
+	u, r := out.ResponseMeta.Usage, *b.record
+	b.ticket.Charge(ctx, int64(u.TotalTokens))
+	apikey.Charge(ctx, int64(u.TotalTokens))
+	r.PromptTokens, r.CompletionTokens = int64(u.PromptTokens), int64(u.CompletionTokens)
+	r.CachedTokens, r.TotalTokens = int64(u.PromptTokenDetails.CachedTokens), int64(u.TotalTokens)
+	r.Latency = time.Since(start).Milliseconds()
+	if m.usage == nil {
+		return
+	}
+	if err := m.usage.Insert(ctx, &r); err != nil {
+		logs.CtxErrorf(ctx, "[memory] record %s usage err: %s", r.Source, errorx.ErrorWithoutStack(err))
+	}
+}
+
 func (m *MemoryManager) RetrieveMemory(ctx context.Context, st *state.RelayContext) (mmsgs []*mmsg.Message, err error) {
-	// 获取历史记录
-	mmsgs, err = m.his.RetrieveMessage(ctx, st.Info.ConversationId.Hex(), 0)
-	return
+	// 获取当前段落激活分支上的历史记录, 最终放入上下文的部分由BuildContext按token上限决定
+	inf := st.Info
+	if inf.Tree, err = m.his.RetrieveTree(ctx, inf.ConversationId.Hex(), inf.SectionId.Hex()); err != nil {
+		return nil, err
+	}
+	inf.NextIndex = max(inf.SectionStart, inf.Tree.LastIndex()+1)
+	return LimitMessages(inf.Tree.ActivePath()), nil
 }
 
-func (m *MemoryManager) StoreHistory(ctx context.Context, relay *state.RelayContext) (err error) {
+// LimitMessages 只保留倒序历史记录中最近的部分, 不超过上下文的消息数上限
+func LimitMessages(his []*mmsg.Message) []*mmsg.Message {
+	if size := model.ContextConf().MaxMessages; size > 0 && len(his) > size {
+		return his[:size]
+	}
+	return his
+}
+
+func (m *MemoryManager) StoreHistory(ctx context.Context, relay *state.RelayContext, ticket *limit.Ticket) (err error) {
 	var update []*mmsg.Message
 	info := relay.Info
 	switch info.CompletionOptions.Typ {
 	case cst.Regen:
 		update = append(update, info.CompletionOptions.RegenList...)
-	case cst.Replace:
+	case cst.Replace, cst.Edit:
 		update = append(update, info.CompletionOptions.ReplaceList...)
 	case cst.SelectRegen:
 		update = append(update, info.CompletionOptions.SelectRegenList...)
 	}
-	if err = m.his.UpdateMessages(ctx, update); err != nil {
-		logs.Errorf("[domain message] process history option err: %s", errorx.ErrorWithoutStack(err))
-		return err
-	}
-
-	// 用户消息
-	if err = m.his.AddMessage(context.WithoutCancel(ctx), info.UserMessage.ConversationId.Hex(), info.UserMessage); err != nil {
-		logs.Errorf("[domain message] store user message err: %s", errorx.ErrorWithoutStack(err))
-	}
 	completeAssistantMMsg(relay)
-	// 模型消息
-	if err = m.his.AddMessage(context.WithoutCancel(ctx), info.MessageInfo.AssistantMessage.ConversationId.Hex(), info.MessageInfo.AssistantMessage); err != nil {
-		logs.Errorf("[domain message] store assistant message err: %s", errorx.ErrorWithoutStack(err))
+
+	if len(update) > 0 { // 分叉时旧分支的状态与新消息在同一事务中写入, 避免激活路径断开或出现两个激活分支
+		insert := []*mmsg.Message{info.MessageInfo.AssistantMessage}
+		if info.UserMessage != nil {
+			insert = append([]*mmsg.Message{info.UserMessage}, insert...)
+		}
+		if err = m.his.Fork(context.WithoutCancel(ctx), update, insert); err != nil {
+			logs.Errorf("[domain message] store history fork err: %s", errorx.ErrorWithoutStack(err))
+			return err
+		}
+	} else {
+		// 用户消息, 重新生成时没有
+		if info.UserMessage != nil {
+			if err = m.his.AddMessage(context.WithoutCancel(ctx), info.UserMessage.ConversationId.Hex(), info.UserMessage); err != nil {
+				logs.Errorf("[domain message] store user message err: %s", errorx.ErrorWithoutStack(err))
+			}
+		}
+		// 模型消息
+		if err = m.his.AddMessage(context.WithoutCancel(ctx), info.MessageInfo.AssistantMessage.ConversationId.Hex(), info.MessageInfo.AssistantMessage); err != nil {
+			logs.Errorf("[domain message] store assistant message err: %s", errorx.ErrorWithoutStack(err))
+		}
 	}
+	// 异步更新对话摘要与用户记忆
+	m.Summarize(ctx, relay, ticket)
+	m.ExtractFacts(ctx, relay, ticket)
 	return
 }
 
@@ -68,22 +139,29 @@ func completeAssistantMMsg(st *state.RelayContext) {
 		Think:    info.MessageInfo.Think,
 		Suggest:  info.MessageInfo.Suggest,
 	}
-	if info.SearchInfo != nil { // 搜索信息
-		am.Ext.Cite = info.SearchInfo.Cite
+	if si := info.SearchInfo; si != nil { // 搜索信息
+		si.Lock()
+		am.Ext.Cite, am.Ext.InvalidCite = si.Cite, si.Invalid
+		si.Unlock()
 	}
 	if info.Sensitive.Hits != nil && len(info.Sensitive.Hits) > 0 { // 敏感词信息
 		am.Content = ""
 		am.Ext.Sensitive = true
 	}
-	if info.ResponseMeta != nil { // 用量信息
+	if u, estimated := info.Usage(); !estimated { // 用量信息
 		am.Ext.Usage = &mmsg.Usage{
-			PromptTokens: info.ResponseMeta.Usage.PromptTokens,
-			PromptTokenDetails: &mmsg.PromptTokenDetails{
-				CachedTokens: info.ResponseMeta.Usage.PromptTokenDetails.CachedTokens,
-			},
-			CompletionTokens: info.ResponseMeta.Usage.CompletionTokens,
-			TotalTokens:      info.ResponseMeta.Usage.TotalTokens,
+			PromptTokens:       u.PromptTokens,
+			PromptTokenDetails: &mmsg.PromptTokenDetails{CachedTokens: u.PromptTokenDetails.CachedTokens},
+			CompletionTokens:   u.CompletionTokens,
+			TotalTokens:        u.TotalTokens,
+		}
+	}
+	if ci := info.ContextInfo; ci != nil && (ci.Dropped > 0 || ci.Compressed > 0 || ci.Summarized > 0) { // 上下文裁剪信息
+		if am.Ext.Usage == nil {
+			am.Ext.Usage = &mmsg.Usage{}
 		}
+		am.Ext.Usage.Context = &mmsg.ContextUsage{Limit: ci.Limit, Tokens: ci.Tokens, Dropped: ci.Dropped, Compressed: ci.Compressed, Summarized: ci.Summarized}
 	}
 	am.Ext.Code = info.MessageInfo.Code
+	am.Ext.ToolCalls = info.MessageInfo.ToolCalls // 工具调用记录
 }
@@ -0,0 +1,208 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/limit"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/memory/window"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/message"
+	dmodel "github.com/xh-polaris/innospark-core-api/biz/domain/model"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/usage"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	summaryTimeout       = time.Minute // 单次生成摘要的超时时间
+	summaryMessageTokens = 1000        // 生成摘要时单条消息的最大token数
+	summaryPrefix        = "以下是此前对话的摘要, 其中的原始对话已省略, 请结合摘要理解后续对话:\n"
+)
+
+const defaultSummaryTemplate = `你是对话摘要助手, 需要将已有摘要与新增的对话内容合并为一份新的摘要, 供后续对话作为背景参考。
+要求:
+1. 保留用户的身份、偏好、目标与约束, 以及对话中已确定的结论、关键数据和未完成的事项
+2. 省略寒暄与重复内容, 不要编造对话中没有的信息
+3. 使用第三人称客观陈述, 不超过{max}字
+4. 只输出摘要正文
+
+已有摘要:
+{summary}
+
+新增对话:
+{history}`
+
+var summarizing sync.Map // 正在生成摘要的对话, 避免同一对话并发生成
+
+// ApplySummary 使用对话摘要替代已被摘要覆盖的历史记录, his为倒序的历史记录
+// 摘要覆盖的消息被重新生成、替换或切换到其他分支时摘要失效, 本次不使用, 并在对话结束后重新生成
+func (m *MemoryManager) ApplySummary(ctx context.Context, st *state.RelayContext, his []*mmsg.Message) []*mmsg.Message {
+	c, err := m.conv.GetConversation(ctx, st.Info.ConversationId.Hex())
+	if err != nil {
+		logs.CtxErrorf(ctx, "[memory] get conversation summary err: %s", errorx.ErrorWithoutStack(err))
+		return his
+	}
+	si := &info.SummaryInfo{EndIndex: -1}
+	st.Info.SummaryInfo = si
+	if c.Summary == nil || c.Summary.SectionId != st.Info.SectionId { // 新的段落不沿用此前的摘要
+		return his
+	}
+	si.Content, si.EndIndex, si.EndMessageId = c.Summary.Content, c.Summary.EndIndex, c.Summary.EndMessageId
+
+	opt := st.Info.CompletionOptions
+	for _, list := range [][]*mmsg.Message{opt.RegenList, opt.ReplaceList, opt.SelectRegenList} {
+		for _, msg := range list {
+			if msg.Index <= si.EndIndex {
+				si.Stale = true
+				return his
+			}
+		}
+	}
+
+	for i, msg := range his { // 历史记录按索引倒序
+		if msg.Index <= si.EndIndex {
+			if !si.EndMessageId.IsZero() && !onPath(his[i:], si.EndMessageId) { // 摘要覆盖的是其他分支
+				si.Stale = true
+				return his
+			}
+			for _, covered := range his[i:] {
+				if effective(covered) {
+					si.Covered++
+				}
+			}
+			return his[:i]
+		}
+	}
+	return his
+}
+
+// Summarize 对话结束后异步检查是否需要生成摘要
+// 未被摘要覆盖的有效消息数超过阈值时, 将最近几轮之前的对话与已有摘要合并为新的摘要
+func (m *MemoryManager) Summarize(ctx context.Context, st *state.RelayContext, ticket *limit.Ticket) {
+	c, si := summaryConf(), st.Info.SummaryInfo
+	if c.Threshold <= 0 || si == nil { // 未能读取已有摘要时不生成, 避免覆盖
+		return
+	}
+	cid, sid, uid := st.Info.ConversationId.Hex(), st.Info.SectionId, st.Info.UserId.Hex()
+	if _, loaded := summarizing.LoadOrStore(cid, struct{}{}); loaded {
+		return
+	}
+	prev, b := *si, NewBilling(ctx, st.Info, ticket, usage.SourceSummary, dmodel.DoubaoFlash)
+	go func() {
+		defer summarizing.Delete(cid)
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), summaryTimeout)
+		defer cancel()
+		if err := m.summarize(ctx, c, b, uid, cid, sid, &prev); err != nil {
+			logs.Errorf("[memory] summarize conversation %s err: %s", cid, errorx.ErrorWithoutStack(err))
+		}
+	}()
+}
+
+func (m *MemoryManager) summarize(ctx context.Context, c *conf.Summary, b *Billing, uid, cid string, sid primitive.ObjectID, prev *info.SummaryInfo) (err error) {
+	if prev.Stale { // 摘要失效, 从头重新生成
+		prev.Content, prev.EndIndex = "", -1
+	}
+	his, err := m.his.RetrieveMessage(ctx, cid, sid.Hex(), dmodel.ContextConf().MaxMessages)
+	if err != nil {
+		return err
+	}
+
+	// 倒序遍历, 跳过保留原文的最近几轮, 之后未被摘要覆盖的消息即为待摘要的消息
+	var pending []*mmsg.Message
+	uncovered, turns := 0, 0
+	for _, msg := range his {
+		if msg.Index <= prev.EndIndex || !effective(msg) {
+			continue
+		}
+		uncovered++
+		if turns < c.KeepTurns {
+			if msg.Role == cst.UserEnum {
+				turns++
+			}
+			continue
+		}
+		pending = append(pending, msg)
+	}
+	if uncovered <= c.Threshold || len(pending) == 0 {
+		if prev.Stale { // 失效的摘要不再使用
+			return m.conv.UpdateConversationSummary(ctx, cid, nil)
+		}
+		return nil
+	}
+
+	in, err := prompt.FromMessages(schema.FString, schema.UserMessage(util.ZeroDefault(c.Template, defaultSummaryTemplate))).Format(ctx,
+		map[string]any{"summary": util.ZeroDefault(prev.Content, "无"), "history": summaryHistory(pending), "max": c.MaxTokens})
+	if err != nil {
+		return err
+	}
+	cm, err := dmodel.NewDoubaoFlashChatModel(ctx, uid, "")
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	out, err := cm.Generate(ctx, in)
+	if err != nil {
+		return err
+	}
+	m.Account(ctx, b, out, start)
+	content := strings.TrimSpace(out.Content)
+	if content == "" {
+		return nil
+	}
+	logs.Infof("[memory] summarize conversation %s messages=%d end=%d", cid, len(pending), pending[0].Index)
+	return m.conv.UpdateConversationSummary(ctx, cid, &conversation.Summary{
+		SectionId:    sid,
+		Content:      window.TruncateTokens(content, c.MaxTokens),
+		EndIndex:     pending[0].Index,
+		EndMessageId: pending[0].MessageId,
+		UpdateTime:   time.Now(),
+	})
+}
+
+// onPath 消息是否在激活路径上, his为倒序的激活路径
+func onPath(his []*mmsg.Message, mid primitive.ObjectID) bool {
+	for _, msg := range his {
+		if msg.MessageId == mid {
+			return true
+		}
+	}
+	return false
+}
+
+// summaryHistory 将倒序的待摘要消息整理为正序文本
+func summaryHistory(msgs []*mmsg.Message) string {
+	lines := make([]string, 0, len(msgs))
+	for i := len(msgs) - 1; i >= 0; i-- {
+		role := "助手"
+		if msgs[i].Role == cst.UserEnum {
+			role = "用户"
+		}
+		text := strings.TrimSpace(util.GetInputText(message.MMsgToEMsg(msgs[i])))
+		lines = append(lines, role+": "+window.TruncateTokens(text, summaryMessageTokens))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// effective 有效的用户或模型消息, 旧版本中被重新生成或替换清空的消息无效
+func effective(msg *mmsg.Message) bool {
+	return (msg.Role == cst.UserEnum || msg.Role == cst.AssistantEnum) && (msg.Content != "" || len(msg.UserInputMultiContent) > 0)
+}
+
+func summaryConf() *conf.Summary {
+	if c := conf.GetConfig().Summary; c != nil {
+		return c
+	}
+	return &conf.Summary{Threshold: 20, KeepTurns: 4, MaxTokens: 800}
+}
@@ -0,0 +1,169 @@
+package window
+
+import (
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+)
+
+/* 上下文窗口, 按token上限裁剪送入模型的消息 */
+
+const compressSuffix = "...(内容过长已省略)"
+
+// Build 在ci.Limit-reserve的预算内构建上下文, in为倒序的模型消息, 用量与裁剪情况记录在ci中
+// 系统提示词与最新一轮对话总是保留, 较早的对话按轮次从新到旧依次放入,
+// 放不下时先将该轮中的长消息压缩到compressTokens以内, 仍放不下则丢弃该轮及更早的对话
+func Build(in []*schema.Message, ci *info.ContextInfo, reserve, compressTokens int) []*schema.Message {
+	budget := ci.Limit - reserve
+	keep := make([]bool, len(in))
+	// 系统提示词
+	for i, m := range in {
+		if m.Role == schema.System {
+			keep[i], ci.Tokens = true, ci.Tokens+util.EstimateMessageTokens(m)
+		}
+	}
+	// 最新一轮对话, 到最近一条有效的用户消息为止
+	i := 0
+	for ; i < len(in); i++ {
+		if in[i].Role != schema.System {
+			keep[i], ci.Tokens = true, ci.Tokens+util.EstimateMessageTokens(in[i])
+		}
+		if isTurnStart(in[i]) {
+			i++
+			break
+		}
+	}
+	// 较早的对话
+	dropping := false
+	for i < len(in) {
+		end := i
+		for end < len(in) && !isTurnStart(in[end]) {
+			end++
+		}
+		end = min(end+1, len(in)) // [i, end) 为一轮对话
+		if !dropping {
+			dropping = !fitTurn(in[i:end], keep[i:end], ci, budget, compressTokens)
+		}
+		if dropping {
+			for k := i; k < end; k++ {
+				if in[k].Role != schema.System && hasContent(in[k]) {
+					ci.Dropped++
+				}
+			}
+		}
+		i = end
+	}
+
+	out := make([]*schema.Message, 0, len(in))
+	for k, m := range in {
+		if keep[k] {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// InjectSystem 将系统消息放在已有的系统提示词之后、对话之前, in为倒序的模型消息
+func InjectSystem(in []*schema.Message, content string) []*schema.Message {
+	k := len(in)
+	for k > 0 && in[k-1].Role == schema.System {
+		k--
+	}
+	out := make([]*schema.Message, 0, len(in)+1)
+	out = append(out, in[:k]...)
+	out = append(out, schema.SystemMessage(content))
+	return append(out, in[k:]...)
+}
+
+// fitTurn 尝试将一轮对话放入上下文, 放不下时压缩后再次尝试
+func fitTurn(turn []*schema.Message, keep []bool, ci *info.ContextInfo, budget, compressTokens int) bool {
+	tokens := 0
+	for _, m := range turn {
+		if m.Role != schema.System {
+			tokens += util.EstimateMessageTokens(m)
+		}
+	}
+	if ci.Tokens+tokens <= budget {
+		for k, m := range turn {
+			if m.Role != schema.System {
+				keep[k] = true
+			}
+		}
+		ci.Tokens += tokens
+		return true
+	}
+
+	// 压缩长消息
+	compressed, n := make([]*schema.Message, len(turn)), 0
+	tokens = 0
+	for k, m := range turn {
+		if m.Role == schema.System {
+			continue
+		}
+		if compressed[k] = compressMessage(m, compressTokens); compressed[k] != m {
+			n++
+		}
+		tokens += util.EstimateMessageTokens(compressed[k])
+	}
+	if n == 0 || ci.Tokens+tokens > budget {
+		return false
+	}
+	for k, m := range compressed {
+		if m != nil {
+			turn[k], keep[k] = m, true
+		}
+	}
+	ci.Tokens, ci.Compressed = ci.Tokens+tokens, ci.Compressed+n
+	return true
+}
+
+// compressMessage 截断超过token数的消息, 并去除其中的图片, 消息未超过时原样返回
+func compressMessage(m *schema.Message, tokens int) *schema.Message {
+	if util.EstimateMessageTokens(m) <= tokens {
+		return m
+	}
+	cm := *m
+	cm.Content, cm.ReasoningContent = TruncateTokens(m.Content, tokens), ""
+	if len(m.UserInputMultiContent) > 0 {
+		cm.UserInputMultiContent = nil
+		for _, p := range m.UserInputMultiContent {
+			if p.Type == schema.ChatMessagePartTypeText {
+				p.Text = TruncateTokens(p.Text, tokens)
+				cm.UserInputMultiContent = append(cm.UserInputMultiContent, p)
+			}
+		}
+	}
+	return &cm
+}
+
+// TruncateTokens 截取文本开头不超过tokens的部分
+func TruncateTokens(s string, tokens int) string {
+	if util.EstimateTokens(s) <= tokens {
+		return s
+	}
+	var sb strings.Builder
+	cjk, other := 0, 0
+	for _, r := range s {
+		if util.IsCJK(r) {
+			cjk++
+		} else {
+			other++
+		}
+		if cjk+(other+3)/4 > tokens {
+			break
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String() + compressSuffix
+}
+
+// isTurnStart 倒序中的一轮对话以有效的用户消息结束, 即正序中的一轮对话从用户消息开始
+func isTurnStart(m *schema.Message) bool {
+	return m.Role == schema.User && hasContent(m)
+}
+
+func hasContent(m *schema.Message) bool {
+	return m.Content != "" || len(m.UserInputMultiContent) > 0 || len(m.ToolCalls) > 0
+}
@@ -0,0 +1,133 @@
+package window
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+)
+
+// text 由n个汉字组成的文本, 估算为n个token
+func text(n int) string {
+	return strings.Repeat("字", n)
+}
+
+func TestBuild(t *testing.T) {
+	sys := schema.SystemMessage(text(5))
+	q1, a1 := schema.UserMessage(text(10)), schema.AssistantMessage(text(10), nil)
+	q2, a2 := schema.UserMessage(text(10)), schema.AssistantMessage(text(10), nil)
+	q3 := schema.UserMessage(text(10))
+	long := schema.AssistantMessage(text(100), nil)
+	call := schema.AssistantMessage("", []schema.ToolCall{{ID: "1", Function: schema.FunctionCall{Name: "search", Arguments: "{}"}}})
+	result := schema.ToolMessage(text(10), "1")
+	big := schema.UserMessage(text(50))
+	cases := []struct {
+		name           string
+		in             []*schema.Message // 倒序
+		limit, reserve int
+		want           []*schema.Message
+		tokens         int
+		dropped        int
+		compressed     int
+	}{
+		{"all fit", []*schema.Message{q3, a2, q2, a1, q1, sys}, 100, 0,
+			[]*schema.Message{q3, a2, q2, a1, q1, sys}, 55, 0, 0},
+		{"drop oldest turns", []*schema.Message{q3, a2, q2, a1, q1, sys}, 40, 0,
+			[]*schema.Message{q3, a2, q2, sys}, 35, 2, 0},
+		{"reserve counts", []*schema.Message{q3, a2, q2, a1, q1, sys}, 55, 21,
+			[]*schema.Message{q3, sys}, 15, 4, 0},
+		{"stop at first turn that does not fit", []*schema.Message{q3, long, q2, a1, q1, sys}, 40, 0,
+			[]*schema.Message{q3, sys}, 15, 4, 0},
+		{"latest turn always kept", []*schema.Message{big, a1, q1, sys}, 30, 0,
+			[]*schema.Message{big, sys}, 55, 2, 0},
+		{"tool round dropped together", []*schema.Message{q3, a2, result, call, q2, sys}, 30, 0,
+			[]*schema.Message{q3, sys}, 15, 4, 0},
+		{"tool round kept together", []*schema.Message{q3, a2, result, call, q2, sys}, 100, 0,
+			[]*schema.Message{q3, a2, result, call, q2, sys}, 48, 0, 0},
+		{"empty", nil, 100, 0, []*schema.Message{}, 0, 0, 0},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		ci := &info.ContextInfo{Limit: c.limit}
+		out := Build(append([]*schema.Message(nil), c.in...), ci, c.reserve, 256)
+		g.Expect(out).To(Equal(c.want), c.name)
+		g.Expect(ci.Tokens).To(Equal(c.tokens), c.name)
+		g.Expect(ci.Dropped).To(Equal(c.dropped), c.name)
+		g.Expect(ci.Compressed).To(Equal(c.compressed), c.name)
+	}
+}
+
+func TestBuildCompress(t *testing.T) {
+	g := NewGomegaWithT(t)
+	sys, q1, q2 := schema.SystemMessage(text(5)), schema.UserMessage(text(10)), schema.UserMessage(text(10))
+	long := schema.AssistantMessage(text(100), nil)
+	ci := &info.ContextInfo{Limit: 70}
+	out := Build([]*schema.Message{q2, long, q1, sys}, ci, 0, 10)
+	g.Expect(out).To(HaveLen(4))
+	g.Expect(out[1].Content).To(Equal(text(10) + compressSuffix))
+	g.Expect(long.Content).To(Equal(text(100))) // 不修改原消息
+	g.Expect(ci.Compressed).To(Equal(1))
+	g.Expect(ci.Dropped).To(Equal(0))
+	g.Expect(ci.Tokens).To(Equal(5 + 10 + 19 + 10))
+}
+
+func TestInjectSystem(t *testing.T) {
+	sys, q1, a1, q2 := schema.SystemMessage("prompt"), schema.UserMessage("q1"), schema.AssistantMessage("a1", nil), schema.UserMessage("q2")
+	cases := []struct {
+		name string
+		in   []*schema.Message
+		want []string
+	}{
+		{"after system prompt", []*schema.Message{q2, a1, q1, sys}, []string{"q2", "a1", "q1", "summary", "prompt"}},
+		{"no system prompt", []*schema.Message{q2, a1, q1}, []string{"q2", "a1", "q1", "summary"}},
+		{"only system prompt", []*schema.Message{sys}, []string{"summary", "prompt"}},
+		{"empty", nil, []string{"summary"}},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		out := InjectSystem(c.in, "summary")
+		contents := make([]string, len(out))
+		for i, m := range out {
+			contents[i] = m.Content
+		}
+		g.Expect(contents).To(Equal(c.want), c.name)
+		g.Expect(c.in).NotTo(ContainElement(HaveField("Content", "summary")), c.name)
+	}
+}
+
+func TestTruncateTokens(t *testing.T) {
+	cases := []struct {
+		name   string
+		in     string
+		tokens int
+		want   string
+	}{
+		{"short", text(5), 5, text(5)},
+		{"cjk", text(20), 5, text(5) + compressSuffix},
+		{"ascii", "abcdefghijkl", 2, "abcdefgh" + compressSuffix},
+		{"empty", "", 0, ""},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(TruncateTokens(c.in, c.tokens)).To(Equal(c.want), c.name)
+	}
+}
+
+func TestCompressMessage(t *testing.T) {
+	g := NewGomegaWithT(t)
+	short := schema.UserMessage(text(5))
+	g.Expect(compressMessage(short, 10)).To(BeIdenticalTo(short))
+
+	m := &schema.Message{Role: schema.User, ReasoningContent: text(5), UserInputMultiContent: []schema.MessageInputPart{
+		{Type: schema.ChatMessagePartTypeText, Text: text(20)},
+		{Type: schema.ChatMessagePartTypeImageURL, Image: &schema.MessageInputImage{}},
+	}}
+	cm := compressMessage(m, 10)
+	g.Expect(cm).NotTo(BeIdenticalTo(m))
+	g.Expect(cm.ReasoningContent).To(BeEmpty())
+	g.Expect(cm.UserInputMultiContent).To(HaveLen(1))
+	g.Expect(cm.UserInputMultiContent[0].Text).To(Equal(text(10) + compressSuffix))
+	g.Expect(m.UserInputMultiContent).To(HaveLen(2))
+}
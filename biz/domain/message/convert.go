@@ -14,6 +14,32 @@ import (
 func MMsgToEMsgList(messages []*mmsg.Message) (msgs []*schema.Message) {
 	for _, msg := range messages {
 		msgs = append(msgs, MMsgToEMsg(msg))
+		if msg.Ext != nil && len(msg.Ext.ToolCalls) > 0 { // 还原工具调用过程
+			msgs = append(msgs, MToolCallToEMsgList(msg.Ext.ToolCalls)...)
+		}
+	}
+	return
+}
+
+// MToolCallToEMsgList 将工具调用记录还原为模型域消息, 与历史记录一致为倒序
+func MToolCallToEMsgList(calls []*mmsg.ToolCall) (msgs []*schema.Message) {
+	var ordered []*schema.Message
+	for i := 0; i < len(calls); {
+		// 同一轮次的工具调用由一条模型消息发起
+		round, assistant := calls[i].Round, schema.AssistantMessage("", nil)
+		var results []*schema.Message
+		for ; i < len(calls) && calls[i].Round == round; i++ {
+			assistant.ToolCalls = append(assistant.ToolCalls, schema.ToolCall{
+				ID:       calls[i].Id,
+				Type:     "function",
+				Function: schema.FunctionCall{Name: calls[i].Name, Arguments: calls[i].Arguments},
+			})
+			results = append(results, schema.ToolMessage(calls[i].Result, calls[i].Id, schema.WithToolName(calls[i].Name)))
+		}
+		ordered = append(append(ordered, assistant), results...)
+	}
+	for i := len(ordered) - 1; i >= 0; i-- {
+		msgs = append(msgs, ordered[i])
 	}
 	return
 }
@@ -135,14 +161,16 @@ func MMsgToFMsg(msg *mmsg.Message) *core_api.FullMessage {
 		ContentType:    msg.ContentType,
 		Content:        msg.Content,
 		Ext: &core_api.Ext{
-			BotState:  msg.Ext.BotState,
-			Brief:     msg.Ext.Brief,
-			Think:     msg.Ext.Think,
-			Suggest:   msg.Ext.Suggest,
-			Cite:      MCiteToFCiteList(msg.Ext.Cite),
-			Code:      MCodeToFCodeList(msg.Ext.Code),
-			Sensitive: msg.Ext.Sensitive,
-			Usage:     MUsageToFUsage(msg.Ext.Usage),
+			BotState:    msg.Ext.BotState,
+			Brief:       msg.Ext.Brief,
+			Think:       msg.Ext.Think,
+			Suggest:     msg.Ext.Suggest,
+			Cite:        MCiteToFCiteList(msg.Ext.Cite),
+			Code:        MCodeToFCodeList(msg.Ext.Code),
+			Sensitive:   msg.Ext.Sensitive,
+			Usage:       MUsageToFUsage(msg.Ext.Usage),
+			ToolCalls:   MToolCallToFToolCallList(msg.Ext.ToolCalls),
+			InvalidCite: msg.Ext.InvalidCite,
 		},
 		Feedback: msg.Feedback,
 		UserType: msg.Role,
@@ -151,6 +179,9 @@ func MMsgToFMsg(msg *mmsg.Message) *core_api.FullMessage {
 		reply := msg.ReplyId.Hex()
 		fm.ReplyId = &reply
 	}
+	if !msg.ParentId.IsZero() { // 分支信息
+		fm.ParentId, fm.Branch, fm.Inactive = msg.ParentId.Hex(), msg.Branch, msg.Inactive
+	}
 	if msg.UserInputMultiContent != nil {
 		for _, c := range msg.UserInputMultiContent {
 			part := &core_api.MessageInputPart{
@@ -251,6 +282,7 @@ func MCiteToFCite(cite *mmsg.Cite) *core_api.Cite {
 		SiteName:      cite.SiteName,
 		SiteIcon:      cite.SiteIcon,
 		DatePublished: cite.DatePublished,
+		Used:          cite.Used,
 	}
 }
 
@@ -268,6 +300,23 @@ func MCodeToFCode(code *mmsg.Code) *core_api.Code {
 	}
 }
 
+func MToolCallToFToolCallList(calls []*mmsg.ToolCall) (cs []*core_api.ToolCall) {
+	for _, c := range calls {
+		cs = append(cs, MToolCallToFToolCall(c))
+	}
+	return
+}
+
+func MToolCallToFToolCall(call *mmsg.ToolCall) *core_api.ToolCall {
+	return &core_api.ToolCall{
+		Id:        call.Id,
+		Round:     call.Round,
+		Name:      call.Name,
+		Arguments: call.Arguments,
+		Result:    call.Result,
+	}
+}
+
 func MUsageToFUsage(usage *mmsg.Usage) *core_api.Usage {
 	if usage == nil {
 		return nil
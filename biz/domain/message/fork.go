@@ -0,0 +1,45 @@
+package message
+
+import (
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/infra/mapper/conversation"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ForkMMsgs 将倒序的激活路径复制到新对话中, 按正序重新编号索引, 并返回新对话的段落
+// 原有的段落边界保留, 第一个段落的id与新对话id相同; 旧版本中被清空的消息不复制
+func ForkMMsgs(path []*mmsg.Message, cid primitive.ObjectID) (msgs []*mmsg.Message, sections []*conversation.Section) {
+	now := time.Now()
+	ids := map[primitive.ObjectID]primitive.ObjectID{}     // 原消息id -> 新消息id
+	sids := map[primitive.ObjectID]primitive.ObjectID{}    // 原段落id -> 新段落id
+	parents := map[primitive.ObjectID]primitive.ObjectID{} // 新段落id -> 段落中上一条消息id
+	for i := len(path) - 1; i >= 0; i-- {
+		ori := path[i]
+		if ori.Content == "" && len(ori.UserInputMultiContent) == 0 && len(ori.AssistantGenMultiContent) == 0 && (ori.Ext == nil || !ori.Ext.Sensitive) {
+			continue
+		}
+		sid, ok := sids[ori.SectionId]
+		if !ok {
+			if sid = cid; len(sids) > 0 { // 新的段落
+				sid = primitive.NewObjectID()
+				sections = append(sections, &conversation.Section{SectionId: sid, StartIndex: int32(len(msgs)), CreateTime: now})
+			}
+			sids[ori.SectionId], parents[sid] = sid, sid
+		}
+		msg := *ori
+		msg.MessageId, msg.ConversationId, msg.SectionId = primitive.NewObjectID(), cid, sid
+		msg.Index, msg.ParentId, msg.Branch, msg.Inactive = int32(len(msgs)), parents[sid], 0, false
+		msg.Feedback, msg.UpdateTime = 0, now
+		if !ori.ReplyId.IsZero() {
+			msg.ReplyId = ids[ori.ReplyId]
+		}
+		ids[ori.MessageId], parents[sid] = msg.MessageId, msg.MessageId
+		msgs = append(msgs, &msg)
+	}
+	for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+		msgs[i], msgs[j] = msgs[j], msgs[i]
+	}
+	return msgs, sections
+}
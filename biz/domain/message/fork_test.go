@@ -0,0 +1,92 @@
+package message
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// newPath 按正序构造消息, 返回倒序的激活路径; sections[i]为第i条消息所属的段落序号, 内容为空的消息模拟被清空的旧消息
+func newPath(contents []string, sections []int) []*mmsg.Message {
+	var sids []primitive.ObjectID
+	var path []*mmsg.Message
+	var prev *mmsg.Message
+	for i, content := range contents {
+		for len(sids) <= sections[i] {
+			sids = append(sids, primitive.NewObjectID())
+		}
+		msg := &mmsg.Message{MessageId: primitive.NewObjectID(), SectionId: sids[sections[i]], Index: int32(i), Content: content,
+			Role: cst.UserEnum, Branch: 1, Inactive: true, Feedback: 1}
+		if msg.ParentId = msg.SectionId; prev != nil && prev.SectionId == msg.SectionId {
+			msg.ParentId = prev.MessageId
+		}
+		if i%2 == 1 {
+			msg.Role, msg.ReplyId = cst.AssistantEnum, prev.MessageId
+		}
+		path, prev = append([]*mmsg.Message{msg}, path...), msg
+	}
+	return path
+}
+
+func TestForkMMsgs(t *testing.T) {
+	cases := []struct {
+		name     string
+		contents []string
+		sections []int
+		want     []string // 复制后的内容, 正序
+		newSecs  int      // 新增的段落数
+	}{
+		{"empty", nil, nil, nil, 0},
+		{"single section", []string{"q1", "a1", "q2", "a2"}, []int{0, 0, 0, 0}, []string{"q1", "a1", "q2", "a2"}, 0},
+		{"multi sections", []string{"q1", "a1", "q2", "a2", "q3"}, []int{0, 0, 1, 1, 2}, []string{"q1", "a1", "q2", "a2", "q3"}, 2},
+		{"skip cleared", []string{"q1", "", "q2", "a2"}, []int{0, 0, 0, 0}, []string{"q1", "q2", "a2"}, 0},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		cid := primitive.NewObjectID()
+		path := newPath(c.contents, c.sections)
+		msgs, sections := ForkMMsgs(path, cid)
+		g.Expect(sections).To(HaveLen(c.newSecs), c.name)
+		g.Expect(msgs).To(HaveLen(len(c.want)), c.name)
+
+		forked := map[primitive.ObjectID]*mmsg.Message{}
+		for _, msg := range msgs {
+			forked[msg.MessageId] = msg
+		}
+		for i := range msgs { // 倒序遍历为正序
+			msg := msgs[len(msgs)-1-i]
+			g.Expect(msg.Content).To(Equal(c.want[i]), c.name)
+			g.Expect(msg.Index).To(Equal(int32(i)), c.name)
+			g.Expect(msg.ConversationId).To(Equal(cid), c.name)
+			g.Expect(msg.Inactive).To(BeFalse(), c.name)
+			g.Expect(msg.Branch).To(BeZero(), c.name)
+			g.Expect(msg.Feedback).To(BeZero(), c.name)
+			if parent := forked[msg.ParentId]; parent != nil {
+				g.Expect(parent.SectionId).To(Equal(msg.SectionId), c.name)
+				g.Expect(parent.Index).To(BeNumerically("<", msg.Index), c.name)
+			} else {
+				g.Expect(msg.ParentId).To(Equal(msg.SectionId), c.name) // 段落的第一条消息
+			}
+			if !msg.ReplyId.IsZero() {
+				g.Expect(forked).To(HaveKey(msg.ReplyId), c.name)
+			}
+		}
+		if len(msgs) > 0 {
+			g.Expect(msgs[len(msgs)-1].SectionId).To(Equal(cid), c.name) // 第一个段落与对话id相同
+		}
+		for i, s := range sections {
+			g.Expect(s.SectionId).NotTo(Equal(cid), c.name)
+			first := msgs[len(msgs)-1-int(s.StartIndex)]
+			g.Expect(first.SectionId).To(Equal(s.SectionId), c.name)
+			if i > 0 {
+				g.Expect(s.StartIndex).To(BeNumerically(">", sections[i-1].StartIndex), c.name)
+			}
+		}
+		for _, ori := range path { // 原消息不被修改
+			g.Expect(ori.Inactive).To(BeTrue(), c.name)
+		}
+	}
+}
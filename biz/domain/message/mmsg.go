@@ -68,6 +68,7 @@ func NewModelMMsg(relay *state.RelayContext, index int) *mmsg.Message {
 		UserId:         relay.Info.UserId,
 		Index:          int32(index),
 		ReplyId:        replayId,
+		ParentId:       replayId, // 模型消息的父消息即回复的用户消息
 		ContentType:    cst.ContentTypeText,
 		MessageType:    cst.ContentTypeText,
 		Ext:            nil,
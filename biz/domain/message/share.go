@@ -0,0 +1,37 @@
+package message
+
+import (
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SnapshotMMsgs 截取倒序激活路径上从start到end的消息并复制为分享快照, start与end为空时分别取路径的首尾
+// 快照只保留用户与模型消息, 不包含反馈; start或end不在路径上, 或start晚于end时返回nil
+func SnapshotMMsgs(path []*mmsg.Message, start, end primitive.ObjectID) []*mmsg.Message {
+	if len(path) == 0 {
+		return nil
+	}
+	from, to := len(path)-1, 0 // 路径为倒序, from为最早的消息
+	for i, msg := range path {
+		if msg.MessageId == start {
+			from = i
+		}
+		if msg.MessageId == end {
+			to = i
+		}
+	}
+	if (!start.IsZero() && path[from].MessageId != start) || (!end.IsZero() && path[to].MessageId != end) || from < to {
+		return nil
+	}
+	var snapshot []*mmsg.Message
+	for _, ori := range path[to : from+1] {
+		if ori.Role != cst.UserEnum && ori.Role != cst.AssistantEnum {
+			continue
+		}
+		msg := *ori
+		msg.Feedback, msg.Terms, msg.ThinkTerms = 0, nil, nil
+		snapshot = append(snapshot, &msg)
+	}
+	return snapshot
+}
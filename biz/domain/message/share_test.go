@@ -0,0 +1,55 @@
+package message
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSnapshotMMsgs(t *testing.T) {
+	// 正序为 u0 a1 t2 u3 a4, t2为工具消息, 倒序路径中依次为 a4 u3 t2 a1 u0
+	path := newPath([]string{"u0", "a1", "t2", "u3", "a4"}, []int{0, 0, 0, 0, 0})
+	path[2].Role = cst.ToolEnum
+	path[1].Terms, path[1].ThinkTerms = []string{"a"}, []string{"b"}
+	at := func(content string) primitive.ObjectID {
+		for _, msg := range path {
+			if msg.Content == content {
+				return msg.MessageId
+			}
+		}
+		return primitive.NewObjectID()
+	}
+	cases := []struct {
+		name       string
+		start, end primitive.ObjectID
+		want       []string // 倒序
+	}{
+		{"whole path", primitive.NilObjectID, primitive.NilObjectID, []string{"a4", "u3", "a1", "u0"}},
+		{"from start", at("u3"), primitive.NilObjectID, []string{"a4", "u3"}},
+		{"to end", primitive.NilObjectID, at("a1"), []string{"a1", "u0"}},
+		{"single", at("a1"), at("a1"), []string{"a1"}},
+		{"range", at("a1"), at("u3"), []string{"u3", "a1"}},
+		{"start after end", at("u3"), at("a1"), nil},
+		{"start not on path", at("x"), primitive.NilObjectID, nil},
+		{"end not on path", primitive.NilObjectID, at("x"), nil},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		snapshot := SnapshotMMsgs(path, c.start, c.end)
+		var got []string
+		for _, msg := range snapshot {
+			got = append(got, msg.Content)
+			g.Expect(msg.Feedback).To(BeZero(), c.name)
+			g.Expect(msg.Terms).To(BeNil(), c.name)
+			g.Expect(msg.ThinkTerms).To(BeNil(), c.name)
+		}
+		g.Expect(got).To(Equal(c.want), c.name)
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(SnapshotMMsgs(nil, primitive.NilObjectID, primitive.NilObjectID)).To(BeNil())
+	g.Expect(path[1].Feedback).NotTo(BeZero()) // 原消息不被修改
+	g.Expect(path[1].Terms).NotTo(BeNil())
+}
@@ -109,5 +109,9 @@ func (c *ARKChatModel) process(ctx context.Context, reader *schema.StreamReader[
 }
 
 func (c *ARKChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
-	return c.cli.WithTools(tools)
+	cli, err := c.cli.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &ARKChatModel{cli: cli.(*ark.ChatModel), model: c.model}, nil
 }
@@ -180,5 +180,9 @@ func (c *ClaudeChatModel) process(ctx context.Context, reader *schema.StreamRead
 }
 
 func (c *ClaudeChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
-	return c.cli.WithTools(tools)
+	cli, err := c.cli.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaudeChatModel{cli: cli.(*openai.ChatModel), model: c.model}, nil
 }
@@ -194,5 +194,9 @@ func (c *InnosparkChatModel) deepThinkProcess(ctx context.Context, reader *schem
 }
 
 func (c *InnosparkChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
-	return c.cli.WithTools(tools)
+	cli, err := c.cli.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &InnosparkChatModel{cli: cli.(*openai.ChatModel), model: c.model}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cloudwego/eino/components/model"
 	"github.com/cloudwego/eino/compose"
 	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
 )
@@ -20,6 +21,23 @@ func RegisterModel(name string, f getModelFunc) {
 	models[name] = f
 }
 
+// ContextConf 模型上下文配置, 未配置时使用默认值
+func ContextConf() *conf.Context {
+	if c := conf.GetConfig().Context; c != nil {
+		return c
+	}
+	return &conf.Context{DefaultLimit: 32000, Reserve: 4096, MaxMessages: 200, CompressTokens: 256}
+}
+
+// ContextLimit 获取模型的上下文token上限
+func ContextLimit(model string) int {
+	c := ContextConf()
+	if limit, ok := c.Limits[model]; ok && limit > 0 {
+		return limit
+	}
+	return c.DefaultLimit
+}
+
 // getModel 获取模型
 func getModel(ctx context.Context, model, uid, botId string) (model.ToolCallingChatModel, error) {
 	fn, ok := models[model]
@@ -29,10 +47,23 @@ func getModel(ctx context.Context, model, uid, botId string) (model.ToolCallingC
 	return fn(ctx, uid, botId)
 }
 
+// Registered 模型是否已注册
+func Registered(name string) bool {
+	_, ok := models[name]
+	return ok
+}
+
+// GetModel 按名称获取模型, 用于图外的单次调用, 图内应使用ModelFactory
+func GetModel(ctx context.Context, model, uid, botId string) (model.ToolCallingChatModel, error) {
+	return getModel(ctx, model, uid, botId)
+}
+
 type ModelFactory struct {
 	// 覆盖消息, 优先级高于全局消息
-	model string
-	botId string
+	model  string
+	botId  string
+	pinBot bool               // 是否固定botId, 固定为空时不使用对话的智能体
+	tools  []*schema.ToolInfo // 绑定的工具
 }
 
 func NewModelFactory(opts ...ModelFactoryOpt) model.ToolCallingChatModel {
@@ -53,7 +84,7 @@ func WithModel(model string) ModelFactoryOpt {
 
 func WithBotId(botId string) ModelFactoryOpt {
 	return func(m *ModelFactory) {
-		m.botId = botId
+		m.botId, m.pinBot = botId, true
 	}
 }
 
@@ -74,7 +105,8 @@ func (m *ModelFactory) Stream(ctx context.Context, in []*schema.Message, opts ..
 	// messages翻转顺序, 调用模型时消息应该正序
 	var reverse []*schema.Message
 	for i := len(in) - 1; i >= 0; i-- {
-		if in[i].Content != "" || len(in[i].UserInputMultiContent) != 0 || len(in[i].AssistantGenMultiContent) != 0 {
+		if in[i].Content != "" || len(in[i].UserInputMultiContent) != 0 || len(in[i].AssistantGenMultiContent) != 0 ||
+			len(in[i].ToolCalls) != 0 || in[i].Role == schema.Tool { // 工具调用消息内容可能为空
 			in[i].Name = ""
 			reverse = append(reverse, in[i])
 		}
@@ -90,16 +122,23 @@ func (m *ModelFactory) Stream(ctx context.Context, in []*schema.Message, opts ..
 	return sr, nil
 }
 
+// WithTools 返回绑定工具的模型工厂, 实际模型在调用时绑定
 func (m *ModelFactory) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
-	return nil, nil
+	return &ModelFactory{model: m.model, botId: m.botId, pinBot: m.pinBot, tools: tools}, nil
 }
 
 func (m *ModelFactory) get(ctx context.Context) (cm model.ToolCallingChatModel, err error) {
 	err = compose.ProcessState(ctx, func(ctx context.Context, s *state.RelayContext) (err error) {
 		mo := util.ZeroDefault(m.model, s.Info.ModelInfo.Model)
-		botId := util.ZeroDefault(m.botId, s.Info.ModelInfo.BotId)
+		botId := m.botId
+		if !m.pinBot {
+			botId = s.Info.ModelInfo.BotId
+		}
 		cm, err = getModel(ctx, mo, s.Info.UserId.Hex(), botId)
 		return
 	})
+	if err == nil && len(m.tools) > 0 {
+		cm, err = cm.WithTools(m.tools)
+	}
 	return cm, err
 }
@@ -101,5 +101,9 @@ func (c *QwenChatModel) process(ctx context.Context, reader *schema.StreamReader
 }
 
 func (c *QwenChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
-	return c.cli.WithTools(tools)
+	cli, err := c.cli.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &QwenChatModel{cli: cli.(*qwen.ChatModel), model: c.model}, nil
 }
@@ -57,5 +57,9 @@ func (c *SafeInnosparkChatModel) Stream(ctx context.Context, in []*schema.Messag
 }
 
 func (c *SafeInnosparkChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
-	return c.cli.WithTools(tools)
+	cli, err := c.cli.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeInnosparkChatModel{cli: cli.(*openai.ChatModel), model: c.model}, nil
 }
@@ -75,5 +75,5 @@ var ss = []*schema.Message{
 }
 
 func (m *TestModelFactory) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
-	return nil, nil
+	return m, nil
 }
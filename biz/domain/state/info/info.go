@@ -4,6 +4,8 @@ package info
 
 import (
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cloudwego/eino/schema"
 	"github.com/cloudwego/hertz/pkg/app"
@@ -23,16 +25,25 @@ type Info struct {
 	MessageInfo       *MessageInfo       // 消息信息
 	ConversationId    primitive.ObjectID // 对话id
 	SectionId         primitive.ObjectID // 段落id
+	SectionStart      int32              // 段落第一条消息的索引
+	NextIndex         int32              // 下一条新消息的索引, 在段落的所有分支中递增
+	Tree              *mmsg.Tree         // 段落的消息树
 	UserId            primitive.ObjectID // 用户id
 	ReplyId           string             // 响应ID
 	OriginMessage     *ReqMessage        // 用户原始消息
 	UserMessage       *mmsg.Message      // 用户消息
-	//Profile           *user.Profile        // 用户个性化配置
-	Ext          map[string]string    // 额外配置
-	ResponseMeta *schema.ResponseMeta // 用量
-	SearchInfo   *SearchInfo          // 搜素信息
-	Sensitive    *Sensitive
-	Attach       []string // 附件信息
+	Profile           *user.Profile      // 用户个性化配置
+	Ext               map[string]string  // 额外配置
+	SearchInfo        *SearchInfo        // 搜素信息
+	ContextInfo       *ContextInfo       // 上下文裁剪信息
+	SummaryInfo       *SummaryInfo       // 对话摘要信息
+	StartTime         time.Time          // 请求开始时间, 用于统计耗时
+	Sensitive         *Sensitive
+	Attach            []string // 附件信息
+
+	usageMu  sync.Mutex
+	usage    *schema.TokenUsage // 本轮各次模型调用的用量之和
+	reported bool               // 回答的模型是否返回了用量
 }
 
 func NewInfo(c *app.RequestContext, req *core_api.CompletionsReq, u *user.User, conversationId, sectionId primitive.ObjectID) (info *Info) {
@@ -45,11 +56,12 @@ func NewInfo(c *app.RequestContext, req *core_api.CompletionsReq, u *user.User,
 			SelectedRegenId: req.CompletionsOption.SelectedRegenId}, // 确定重新生成用
 		Ext: util.NilDefault(req.CompletionsOption.Ext, map[string]string{}), // 额外信息(用于cotea模式)
 		ModelInfo: &ModelInfo{
-			Model:     req.Model,                            // 模型名称
-			BotId:     req.BotId,                            // agent名称
-			WebSearch: req.CompletionsOption.GetWebSearch(), // 是否搜索
-			Thinking:  req.CompletionsOption.UseDeepThink,   // 是否深度思考
-			Suggest:   req.CompletionsOption.GetSuggest(),   // 是否建议
+			Model:        req.Model,                                                // 模型名称
+			BotId:        req.BotId,                                                // agent名称
+			WebSearch:    req.CompletionsOption.GetWebSearch(),                     // 是否搜索
+			Thinking:     req.CompletionsOption.UseDeepThink,                       // 是否深度思考
+			Suggest:      req.CompletionsOption.GetSuggest(),                       // 是否建议
+			SearchOption: NewSearchOption(req.CompletionsOption.GetSearchOption()), // 搜索配置
 		},
 		MessageInfo:    &MessageInfo{}, // 消息信息
 		ConversationId: conversationId, // 对话id
@@ -60,12 +72,15 @@ func NewInfo(c *app.RequestContext, req *core_api.CompletionsReq, u *user.User,
 			ContentType: req.Messages[0].ContentType,                                      // 原始消息类型
 			Attaches:    req.Messages[0].Attaches, References: req.Messages[0].References, // 附件
 		},
-		Sensitive: &Sensitive{}, // 命中的敏感词
+		Sensitive:  &Sensitive{},  // 命中的敏感词
+		SearchInfo: &SearchInfo{}, // 搜索信息
+		StartTime:  time.Now(),    // 请求开始时间
 	}
 	if v, ok := inf.Ext["query"]; !ok || v == "" {
 		inf.Ext["query"] = req.Messages[0].Content // 将用户原始提问存入query中, 简化可能存在的提示词注入
 	}
 	profile := util.NilDefault(u.Profile, &user.Profile{}) // 个性化信息
+	inf.Profile = profile
 	if v, ok := inf.Ext[cst.Role]; (!ok || v == "") && profile.Role != nil {
 		inf.Ext[cst.Role] = util.Deref(profile.Role) // 用户角色
 	}
@@ -78,6 +93,54 @@ func NewInfo(c *app.RequestContext, req *core_api.CompletionsReq, u *user.User,
 	return inf
 }
 
+// AddModelUsage 累加回答的模型一次调用的用量, 工具调用的多轮模型都计入
+func (i *Info) AddModelUsage(u *schema.TokenUsage) {
+	if u == nil {
+		return
+	}
+	i.usageMu.Lock()
+	defer i.usageMu.Unlock()
+	i.reported = true
+	i.addUsage(u)
+}
+
+// AddUsage 累加一次辅助模型调用的用量, 如建议与表单提取, 不影响回答的用量是否需要估算
+func (i *Info) AddUsage(u *schema.TokenUsage) {
+	if u == nil {
+		return
+	}
+	i.usageMu.Lock()
+	defer i.usageMu.Unlock()
+	i.addUsage(u)
+}
+
+func (i *Info) addUsage(u *schema.TokenUsage) {
+	if i.usage == nil {
+		i.usage = &schema.TokenUsage{}
+	}
+	i.usage.PromptTokens += u.PromptTokens
+	i.usage.PromptTokenDetails.CachedTokens += u.PromptTokenDetails.CachedTokens
+	i.usage.CompletionTokens += u.CompletionTokens
+	i.usage.TotalTokens += u.TotalTokens
+}
+
+// Usage 本轮对话的用量, 回答的模型没有返回用量时按回答的文本估算, 再加上辅助模型调用的用量
+func (i *Info) Usage() (usage *schema.TokenUsage, estimated bool) {
+	i.usageMu.Lock()
+	defer i.usageMu.Unlock()
+	usage = &schema.TokenUsage{}
+	if i.usage != nil {
+		*usage = *i.usage
+	}
+	if i.reported {
+		return usage, false
+	}
+	completion := util.EstimateTokens(i.MessageInfo.Text) + util.EstimateTokens(i.MessageInfo.Think)
+	usage.CompletionTokens += completion
+	usage.TotalTokens += completion
+	return usage, true
+}
+
 // CompletionOptions 是对话相关配置
 type CompletionOptions struct {
 	Typ             string
@@ -85,6 +148,7 @@ type CompletionOptions struct {
 	IsRegen         bool
 	IsReplace       bool
 	SelectedRegenId *string
+	EditMessageId   *string
 	RegenList       []*mmsg.Message
 	ReplaceList     []*mmsg.Message
 	SelectRegenList []*mmsg.Message
@@ -96,9 +160,28 @@ type ModelInfo struct {
 	Suggest   bool   // 是否建议
 	Thinking  bool   // 是否深度思考
 	OCR       bool   // 是否调用ocr
+	OCRCalls  int    // ocr调用次数
 	Model     string // 模型名称
 	BotId     string // 智能体id
 	BotName   string // 智能体名称
+
+	SearchOption *SearchOption // 搜索配置
+}
+
+// SearchOption 是搜索相关配置
+type SearchOption struct {
+	Freshness string   // 时间范围
+	Include   []string // 限定搜索的站点
+	Exclude   []string // 排除搜索的站点
+	Count     int      // 引用数量上限
+	Random    bool     // 在排名靠前的网页中随机选取引用, 为false时选取是确定的
+}
+
+func NewSearchOption(opt *core_api.SearchOption) *SearchOption {
+	if opt == nil {
+		return &SearchOption{}
+	}
+	return &SearchOption{Freshness: opt.Freshness, Include: opt.Include, Exclude: opt.Exclude, Count: int(opt.Count)}
 }
 
 type MessageInfo struct {
@@ -108,6 +191,10 @@ type MessageInfo struct {
 	Think                   string       // 思考内容
 	Suggest                 string       // 建议内容
 	Code                    []*mmsg.Code // 代码内容
+
+	Context   []*schema.Message // 模型上下文, 倒序, 工具调用时累积
+	ToolRound int               // 工具调用轮次
+	ToolCalls []*mmsg.ToolCall  // 工具调用记录
 }
 
 type ReqMessage struct {
@@ -117,10 +204,32 @@ type ReqMessage struct {
 	References  []string
 }
 
+// SearchInfo 搜索信息, 由图中的搜索工具写入, 同时被交互域读取, 访问引用时需加锁
 type SearchInfo struct {
-	Find   int          // 找到的数量
-	Choose int          // 选择的数量
-	Cite   []*mmsg.Cite // 引用
+	sync.Mutex
+	Calls   int          // 搜索次数
+	Find    int          // 找到的数量
+	Choose  int          // 选择的数量
+	Cite    []*mmsg.Cite // 引用
+	Invalid []int32      // 回答中不存在的引用索引
+}
+
+// ContextInfo 上下文裁剪信息, token数为估算值
+type ContextInfo struct {
+	Limit      int // 模型上下文上限
+	Tokens     int // 裁剪后的上下文token数
+	Dropped    int // 丢弃的消息数
+	Compressed int // 压缩的消息数
+	Summarized int // 被摘要替代的消息数
+}
+
+// SummaryInfo 对话摘要信息
+type SummaryInfo struct {
+	Content      string             // 摘要内容
+	EndIndex     int32              // 摘要覆盖的最后一条消息索引, 为-1时没有可用的摘要
+	EndMessageId primitive.ObjectID // 摘要覆盖的最后一条消息id
+	Covered      int                // 本次被摘要替代的消息数
+	Stale        bool               // 摘要覆盖的消息被修改或切换到了其他分支, 需要重新生成
 }
 
 type RefineContent struct {
@@ -0,0 +1,73 @@
+package info
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	. "github.com/onsi/gomega"
+)
+
+func usageOf(prompt, cached, completion int) *schema.TokenUsage {
+	u := &schema.TokenUsage{PromptTokens: prompt, CompletionTokens: completion, TotalTokens: prompt + completion}
+	u.PromptTokenDetails.CachedTokens = cached
+	return u
+}
+
+func TestUsage(t *testing.T) {
+	cases := []struct {
+		name          string
+		rounds        []*schema.TokenUsage // 回答的模型各轮调用的用量
+		aux           []*schema.TokenUsage // 辅助模型调用的用量
+		text, think   string
+		want          *schema.TokenUsage
+		wantEstimated bool
+	}{
+		{"single round", []*schema.TokenUsage{usageOf(10, 2, 5)}, nil, "", "", usageOf(10, 2, 5), false},
+		{"tool rounds add up", []*schema.TokenUsage{usageOf(10, 2, 5), usageOf(30, 10, 20), usageOf(5, 0, 1)}, nil, "", "", usageOf(45, 12, 26), false},
+		{"nil usage ignored", []*schema.TokenUsage{nil, usageOf(10, 0, 5), nil}, nil, "", "", usageOf(10, 0, 5), false},
+		{"auxiliary added", []*schema.TokenUsage{usageOf(10, 0, 5)}, []*schema.TokenUsage{usageOf(3, 0, 2)}, "答案", "", usageOf(13, 0, 7), false},
+		{"estimated without usage", []*schema.TokenUsage{nil}, nil, "", "", &schema.TokenUsage{}, true},
+		{"estimated answer", nil, nil, "答案", "思考", usageOf(0, 0, 4), true},
+		{"estimated answer with auxiliary", []*schema.TokenUsage{nil}, []*schema.TokenUsage{usageOf(3, 1, 2)}, "答案", "", usageOf(3, 1, 4), true},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		i := &Info{MessageInfo: &MessageInfo{Text: c.text, Think: c.think}}
+		for _, u := range c.rounds {
+			i.AddModelUsage(u)
+		}
+		for _, u := range c.aux {
+			i.AddUsage(u)
+		}
+		got, estimated := i.Usage()
+		g.Expect(estimated).To(Equal(c.wantEstimated), c.name)
+		g.Expect(got).To(Equal(c.want), c.name)
+	}
+}
+
+func TestUsageCopy(t *testing.T) {
+	g := NewGomegaWithT(t)
+	i := &Info{MessageInfo: &MessageInfo{}}
+	i.AddModelUsage(usageOf(10, 0, 5))
+	got, _ := i.Usage()
+	got.TotalTokens = 0
+	again, _ := i.Usage()
+	g.Expect(again.TotalTokens).To(Equal(15))
+}
+
+func TestAddUsageConcurrent(t *testing.T) {
+	g := NewGomegaWithT(t)
+	i := &Info{MessageInfo: &MessageInfo{}}
+	var wg sync.WaitGroup
+	for n := 0; n < 50; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			i.AddModelUsage(usageOf(2, 1, 1))
+		}()
+	}
+	wg.Wait()
+	got, _ := i.Usage()
+	g.Expect(got).To(Equal(usageOf(100, 50, 50)))
+}
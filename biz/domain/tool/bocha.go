@@ -1,28 +1,23 @@
 package graph
 
-// tool.search.bocha 使用博查API的搜索工具
+// tool.search.bocha 使用博查API的搜索服务
 
 import (
 	"context"
-	"encoding/json"
-	"math/rand/v2"
 	"net/http"
-	"strconv"
 	"strings"
 
-	"github.com/cloudwego/eino/components/tool"
-	"github.com/cloudwego/eino/schema"
-	"github.com/xh-polaris/innospark-core-api/biz/domain/interaction"
-	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
-	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/util/httpx"
 )
 
+const Bocha = "bocha"
+
 const webAPIEndPoint = "https://api.bochaai.com/v1/web-search"
 
-type BochaSearchTool struct {
-	st     *state.RelayContext
+// bochaSearcher 博查WebAPI搜索
+type bochaSearcher struct {
 	apiKey string
 }
 
@@ -66,75 +61,32 @@ type webAPIResp struct {
 	} `json:"data"`
 }
 
-func NewBochaSearchTool(relay *state.RelayContext, apiKey string) WebSearchTool {
-	return &BochaSearchTool{st: relay, apiKey: apiKey}
+func init() {
+	RegisterProvider(Bocha, NewBochaSearcher)
 }
 
-func (t *BochaSearchTool) Info(_ context.Context) (*schema.ToolInfo, error) {
-	return &schema.ToolInfo{
-		Name: "博查API",
-		Desc: "使用博查WebAPI进行联网搜索",
-		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
-			"query": {Type: "String", Desc: "搜索词", Required: true},
-		}),
-	}, nil
+func NewBochaSearcher(_ context.Context) (Searcher, error) {
+	return &bochaSearcher{apiKey: conf.GetConfig().Bocha.APIKey}, nil
 }
 
-func (t *BochaSearchTool) InvokableRun(ctx context.Context, jsonStr string, _ ...tool.Option) (_ string, err error) {
-	var args map[string]any
-	if err = json.Unmarshal([]byte(jsonStr), &args); err != nil {
-		return "", err
-	}
-
-	inf := t.st.Info
-	if err = t.st.EventStream.Write(interaction.SearchStartEvent()); err != nil { // 开始搜索
-		return "", err
-	}
-
+func (s *bochaSearcher) Search(ctx context.Context, query string, opt *info.SearchOption) (_ *Result, err error) {
 	var resp *webAPIResp
 	header := http.Header{}
 	header.Add("Content-Type", "application/json")
-	header.Add("Authorization", "Bearer "+t.apiKey)
-	body := &webAPIReq{Query: args["query"].(string), Freshness: "noLimit", Summary: true}
-	if resp, err = httpx.Post[*webAPIResp](ctx, webAPIEndPoint, header, body); err != nil {
-		return "", err
+	header.Add("Authorization", "Bearer "+s.apiKey)
+	body := &webAPIReq{Query: query, Summary: true}
+	if opt != nil {
+		body.Freshness, body.Count = opt.Freshness, opt.Count
+		body.Include, body.Exclude = strings.Join(opt.Include, "|"), strings.Join(opt.Exclude, "|")
 	}
-
-	// SSE: 查找多少篇?
-	find := resp.Data.WebPages.TotalEstimatedMatches%50 + len(resp.Data.WebPages.Value)
-	if err = t.st.EventStream.Write(interaction.SearchFindEvent(find)); err != nil {
-		return "", err
-	}
-	// 随机引用一下, 避免每次引用次数都是一样多
-	if len(resp.Data.WebPages.Value) > 6 {
-		resp.Data.WebPages.Value = resp.Data.WebPages.Value[:6+rand.IntN(len(resp.Data.WebPages.Value)-5)]
-	}
-	// SSE: 选择多少篇
-	choose := len(resp.Data.WebPages.Value)
-	if err = t.st.EventStream.Write(interaction.SearchChooseEvent(choose)); err != nil {
-		return "", err
+	if resp, err = httpx.Post[*webAPIResp](ctx, webAPIEndPoint, header, body); err != nil {
+		return nil, err
 	}
 
-	// 处理结果, 大模型只需要知道cite编号和summary内容, summary内容暂时选择不存储
-	// index, name, url, snippet, siteName. siteIcon, datePublished都需要给前端
-	var sb strings.Builder
-	var cite []*mmsg.Cite
-	for i, v := range resp.Data.WebPages.Value {
-		sb.WriteString("索引:")
-		sb.WriteString(strconv.Itoa(i))
-		sb.WriteString(strings.Replace(v.Summary, "\n", " ", -1))
-		sb.WriteString("\n")
-		c := &mmsg.Cite{Index: int32(i), Name: v.Name, URL: v.URL, Snippet: strings.Replace(v.Snippet, "\n", " ", -1),
-			SiteName: v.SiteName, SiteIcon: v.SiteIcon, DatePublished: v.DatePublished}
-		cite = append(cite, c)
-		// SSE: 返回引用
-		if err = t.st.EventStream.Write(interaction.SearchCiteEvent(c)); err != nil {
-			return "", err
-		}
-	}
-	if err = t.st.EventStream.Write(interaction.SearchEndEvent()); err != nil {
-		return "", err
+	r := &Result{Find: resp.Data.WebPages.TotalEstimatedMatches%50 + len(resp.Data.WebPages.Value)}
+	for _, v := range resp.Data.WebPages.Value {
+		r.Pages = append(r.Pages, &Page{Name: v.Name, URL: v.URL, Snippet: v.Snippet, Summary: v.Summary,
+			SiteName: v.SiteName, SiteIcon: v.SiteIcon, DatePublished: v.DatePublished})
 	}
-	inf.SearchInfo = &info.SearchInfo{Find: find, Choose: choose, Cite: cite}
-	return sb.String(), nil
+	return r, nil
 }
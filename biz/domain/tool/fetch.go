@@ -0,0 +1,344 @@
+package graph
+
+// tool.fetch 读取网页正文的工具, 供模型在搜索后深入阅读网页, 也用于搜索后自动读取排名靠前的网页
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util/httpx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"golang.org/x/net/html/charset"
+)
+
+const FetchToolName = "fetch_page"
+
+const (
+	robotsTTL     = time.Hour  // robots.txt缓存时间
+	robotsMaxSize = 512 * 1024 // robots.txt最大读取字节数
+	maxRedirects  = 5          // 最大重定向次数
+)
+
+var (
+	InvalidURL          = errors.New("invalid url")
+	RobotsDisallowed    = errors.New("disallowed by robots.txt")
+	UnsupportedContent  = errors.New("unsupported content type")
+	ForbiddenAddress    = errors.New("forbidden address")
+	ChunkOutOfRange     = errors.New("chunk out of range")
+	readableContentType = []string{"text/html", "application/xhtml+xml", "text/plain"}
+)
+
+// FetchedPage 读取结果
+type FetchedPage struct {
+	URL       string
+	Title     string
+	Chunks    []string // 按token预算切分的正文
+	Truncated bool     // 网页过大被截断
+}
+
+// fetchTool 供模型调用的网页读取工具
+type fetchTool struct{}
+
+func NewFetchTool() WebSearchTool {
+	return &fetchTool{}
+}
+
+func (t *fetchTool) Info(_ context.Context) (*schema.ToolInfo, error) {
+	return &schema.ToolInfo{
+		Name: FetchToolName,
+		Desc: "读取网页正文, 当搜索结果的摘要不足以回答问题时, 用于阅读搜索结果中网页的完整内容. 正文较长时会分段返回",
+		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+			"url":   {Type: schema.String, Desc: "网页URL", Required: true},
+			"chunk": {Type: schema.Integer, Desc: "读取第几段正文, 从0开始, 默认为0"},
+		}),
+	}, nil
+}
+
+func (t *fetchTool) InvokableRun(ctx context.Context, jsonStr string, _ ...tool.Option) (_ string, err error) {
+	var args struct {
+		URL   string `json:"url"`
+		Chunk int    `json:"chunk"`
+	}
+	if err = json.Unmarshal([]byte(jsonStr), &args); err != nil {
+		return fmt.Sprintf("读取网页失败: 参数格式错误, %s", err), nil
+	}
+	var p *FetchedPage
+	if p, err = FetchPage(ctx, args.URL); err != nil {
+		// 读取失败时告知模型原因, 由模型决定是否换用其他网页
+		return fmt.Sprintf("读取网页失败: %s", err), nil
+	}
+	if args.Chunk < 0 || args.Chunk >= len(p.Chunks) {
+		return fmt.Sprintf("读取网页失败: %s, 共%d段", ChunkOutOfRange, len(p.Chunks)), nil
+	}
+	var sb strings.Builder
+	sb.WriteString("标题: " + p.Title + "\n")
+	sb.WriteString("URL: " + p.URL + "\n")
+	sb.WriteString(fmt.Sprintf("正文第%d段, 共%d段:\n", args.Chunk, len(p.Chunks)))
+	sb.WriteString(p.Chunks[args.Chunk])
+	return sb.String(), nil
+}
+
+// FetchPage 读取网页并提取正文, 会检查robots.txt与内容类型, 并限制大小与耗时
+func FetchPage(ctx context.Context, rawURL string) (_ *FetchedPage, err error) {
+	c := fetchConf()
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, InvalidURL
+	} else if err = checkURL(u); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.Timeout)*time.Second)
+	defer cancel()
+
+	if !robotsAllowed(ctx, u, c.UserAgent) {
+		return nil, RobotsDisallowed
+	}
+
+	header := http.Header{}
+	header.Set("User-Agent", c.UserAgent)
+	header.Set("Accept", "text/html,application/xhtml+xml,text/plain;q=0.9")
+	respHeader, body, truncated, err := fetchClient.Fetch(ctx, u.String(), header, c.MaxSize)
+	if err != nil {
+		return nil, err
+	}
+	contentType := respHeader.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != "" && !slices.Contains(readableContentType, mediaType) {
+		return nil, UnsupportedContent
+	}
+
+	// 按响应声明或网页meta中的编码转为UTF-8
+	r, err := charset.NewReader(bytes.NewReader(body), contentType)
+	if err != nil {
+		return nil, err
+	}
+	rd := &Readable{}
+	if mediaType == "text/plain" {
+		var sb strings.Builder
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			sb.WriteString(scanner.Text() + "\n")
+		}
+		rd.Text = normalizeText(sb.String())
+	} else if rd, err = ExtractReadable(r); err != nil {
+		return nil, err
+	}
+	if rd.Text == "" {
+		return nil, UnsupportedContent
+	}
+	return &FetchedPage{URL: u.String(), Title: rd.Title, Chunks: ChunkText(rd.Text, c.ChunkTokens), Truncated: truncated}, nil
+}
+
+// FetchPages 并发读取排名前k的网页正文, 读取失败的网页保留原有摘要
+// 网页可能来自搜索缓存或本地语料, 被多个请求共享, 写入正文前先复制, 替换pages中对应的元素
+func FetchPages(ctx context.Context, pages []*Page, k int) {
+	if k > len(pages) {
+		k = len(pages)
+	}
+	var wg sync.WaitGroup
+	for i := range pages[:k] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fp, err := FetchPage(ctx, pages[i].URL)
+			if err != nil {
+				logs.Warnf("[fetch] fetch %s err: %s", pages[i].URL, err)
+				return
+			}
+			p := *pages[i]
+			p.Content = fp.Chunks[0]
+			pages[i] = &p
+		}()
+	}
+	wg.Wait()
+}
+
+// fetchClient 读取外部网页的客户端, 网页地址来自模型或用户, 只允许访问公网地址
+// 在建立连接时校验解析后的IP, 可以同时防止DNS重绑定; 不使用代理, 保证校验的是目标地址
+var fetchClient = &httpx.HttpClient{Client: &http.Client{
+	Transport: &http.Transport{
+		DialContext:           (&net.Dialer{Timeout: 10 * time.Second, Control: dialControl}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return errors.New("too many redirects")
+		}
+		return checkURL(req.URL)
+	},
+}}
+
+// deniedPrefixes 除私有、回环、链路本地等地址外, 其余不应访问的保留地址段
+var deniedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // 本网络
+	netip.MustParsePrefix("100.64.0.0/10"), // 运营商级NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF协议分配
+	netip.MustParsePrefix("198.18.0.0/15"), // 基准测试
+	netip.MustParsePrefix("240.0.0.0/4"),   // 保留地址
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64, 可映射到任意IPv4地址
+}
+
+// publicAddr 判断IP是否为可以访问的公网地址
+func publicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() || addr.IsMulticast() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, p := range deniedPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// dialControl 建立连接前校验DNS解析后的地址
+func dialControl(_, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil || !publicAddr(ap.Addr()) {
+		return ForbiddenAddress
+	}
+	return nil
+}
+
+// checkURL 校验网页地址, 只允许http与https, 主机为IP时直接校验, 域名在建立连接时校验
+func checkURL(u *url.URL) error {
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return InvalidURL
+	}
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil && !publicAddr(addr) {
+		return ForbiddenAddress
+	}
+	return nil
+}
+
+func fetchConf() *conf.Fetch {
+	if c := conf.GetConfig().Fetch; c != nil {
+		return c
+	}
+	return &conf.Fetch{Timeout: 10, MaxSize: 2 * 1024 * 1024, ChunkTokens: 2000, UserAgent: "InnoSparkBot"}
+}
+
+// robotsRules 站点的robots.txt规则
+type robotsRules struct {
+	disallow []string
+	allow    []string
+	expire   time.Time
+}
+
+var robotsCache sync.Map // host -> *robotsRules
+
+// robotsAllowed 判断robots.txt是否允许读取, robots.txt不存在或读取失败时视为允许
+func robotsAllowed(ctx context.Context, u *url.URL, ua string) bool {
+	key := u.Scheme + "://" + u.Host
+	var rules *robotsRules
+	if v, ok := robotsCache.Load(key); ok && time.Now().Before(v.(*robotsRules).expire) {
+		rules = v.(*robotsRules)
+	} else {
+		rules = &robotsRules{expire: time.Now().Add(robotsTTL)}
+		header := http.Header{}
+		header.Set("User-Agent", ua)
+		if _, body, _, err := fetchClient.Fetch(ctx, key+"/robots.txt", header, robotsMaxSize); err == nil {
+			rules.disallow, rules.allow = parseRobots(string(body), ua)
+		}
+		robotsCache.Store(key, rules)
+	}
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	// 最长匹配优先, 长度相同时允许优先
+	matched, allowed := -1, true
+	for _, p := range rules.allow {
+		if strings.HasPrefix(path, p) && len(p) > matched {
+			matched, allowed = len(p), true
+		}
+	}
+	for _, p := range rules.disallow {
+		if strings.HasPrefix(path, p) && len(p) > matched {
+			matched, allowed = len(p), false
+		}
+	}
+	return allowed
+}
+
+// parseRobots 解析robots.txt, 优先使用与ua匹配的分组, 没有时使用*分组
+func parseRobots(content, ua string) (disallow, allow []string) {
+	ua = strings.ToLower(ua)
+	type group struct{ disallow, allow []string }
+	var specific, wildcard *group
+	var current []*group
+	inAgents := false // 是否处于连续的User-agent行中
+	for _, line := range strings.Split(content, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		k, v = strings.ToLower(strings.TrimSpace(k)), strings.TrimSpace(v)
+		switch k {
+		case "user-agent":
+			if !inAgents {
+				current = nil
+			}
+			inAgents = true
+			g := &group{}
+			switch agent := strings.ToLower(v); {
+			case agent == "*":
+				if wildcard == nil {
+					wildcard = g
+				}
+			case agent != "" && strings.Contains(ua, agent):
+				if specific == nil {
+					specific = g
+				}
+			}
+			current = append(current, g)
+		case "disallow", "allow":
+			inAgents = false
+			if v == "" {
+				continue
+			}
+			for _, g := range current {
+				if k == "disallow" {
+					g.disallow = append(g.disallow, v)
+				} else {
+					g.allow = append(g.allow, v)
+				}
+			}
+		default:
+			inAgents = false
+		}
+	}
+	if specific != nil {
+		return specific.disallow, specific.allow
+	}
+	if wildcard != nil {
+		return wildcard.disallow, wildcard.allow
+	}
+	return nil, nil
+}
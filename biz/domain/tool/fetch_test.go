@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseRobots(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cases := []struct {
+		name     string
+		content  string
+		ua       string
+		disallow []string
+		allow    []string
+	}{
+		{"empty", "", "InnoSparkBot", nil, nil},
+		{"wildcard", "User-agent: *\nDisallow: /private\nAllow: /private/public", "InnoSparkBot",
+			[]string{"/private"}, []string{"/private/public"}},
+		{"specific first", "User-agent: *\nDisallow: /\n\nUser-agent: innosparkbot\nDisallow: /tmp", "InnoSparkBot",
+			[]string{"/tmp"}, nil},
+		{"grouped agents", "User-agent: other\nUser-agent: InnoSparkBot\nDisallow: /a # 注释\n", "InnoSparkBot",
+			[]string{"/a"}, nil},
+		{"empty disallow", "User-agent: *\nDisallow:\n", "InnoSparkBot", nil, nil},
+		{"other agent only", "User-agent: other\nDisallow: /", "InnoSparkBot", nil, nil},
+	}
+	for _, c := range cases {
+		disallow, allow := parseRobots(c.content, c.ua)
+		g.Expect(disallow).To(Equal(c.disallow), c.name)
+		g.Expect(allow).To(Equal(c.allow), c.name)
+	}
+}
+
+func TestPublicAddr(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cases := map[string]bool{
+		"8.8.8.8":              true,
+		"2001:4860:4860::8888": true,
+		"127.0.0.1":            false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"100.100.100.200":      false,
+		"0.0.0.0":              false,
+		"::":                   false,
+		"::1":                  false,
+		"fe80::1":              false,
+		"fd00::1":              false,
+		"::ffff:127.0.0.1":     false,
+		"64:ff9b::a00:1":       false,
+	}
+	for addr, want := range cases {
+		g.Expect(publicAddr(netip.MustParseAddr(addr))).To(Equal(want), addr)
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cases := map[string]error{
+		"https://example.com/a":        nil,
+		"http://1.1.1.1/":              nil,
+		"ftp://example.com/":           InvalidURL,
+		"file:///etc/passwd":           InvalidURL,
+		"http:///path":                 InvalidURL,
+		"http://127.0.0.1:8080/":       ForbiddenAddress,
+		"http://169.254.169.254/":      ForbiddenAddress,
+		"http://[::1]/":                ForbiddenAddress,
+		"http://[::ffff:10.0.0.1]:80/": ForbiddenAddress,
+	}
+	for raw, want := range cases {
+		u, err := url.Parse(raw)
+		g.Expect(err).NotTo(HaveOccurred(), raw)
+		if want == nil {
+			g.Expect(checkURL(u)).To(Succeed(), raw)
+		} else {
+			g.Expect(checkURL(u)).To(MatchError(want), raw)
+		}
+	}
+}
+
+// 域名解析到内网地址时在建立连接时拒绝, 重定向到内网地址时同样拒绝
+func TestFetchClientRejectsInternal(t *testing.T) {
+	g := NewGomegaWithT(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("internal"))
+	}))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+
+	_, _, _, err := fetchClient.Fetch(context.Background(), "http://localhost:"+u.Port()+"/", nil, 1024)
+	g.Expect(errors.Is(err, ForbiddenAddress)).To(BeTrue(), "%v", err)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://169.254.169.254/latest/meta-data", nil)
+	g.Expect(fetchClient.Client.CheckRedirect(req, nil)).To(MatchError(ForbiddenAddress))
+	req, _ = http.NewRequest(http.MethodGet, "gopher://example.com/", nil)
+	g.Expect(fetchClient.Client.CheckRedirect(req, nil)).To(MatchError(InvalidURL))
+}
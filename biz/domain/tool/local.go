@@ -0,0 +1,113 @@
+package graph
+
+// tool.search.local 基于本地语料的离线搜索服务, 用于无外网或搜索服务不可用时兜底
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	"github.com/xh-polaris/innospark-core-api/pkg/segment"
+)
+
+const Local = "local"
+
+var NoLocalCorpus = errors.New("local search corpus not configured")
+
+var (
+	localOnce   sync.Once
+	localCorpus []*localDoc
+	localErr    error
+)
+
+type localDoc struct {
+	page  *Page
+	name  map[string]bool // 标题词项
+	terms map[string]bool // 内容词项
+}
+
+// localSearcher 本地语料搜索, 按词项命中数排序
+type localSearcher struct {
+	docs []*localDoc
+}
+
+func init() {
+	RegisterProvider(Local, NewLocalSearcher)
+}
+
+// NewLocalSearcher 加载本地语料, 语料为jsonl格式, 每行一个Page, 仅首次调用时加载
+func NewLocalSearcher(_ context.Context) (Searcher, error) {
+	localOnce.Do(func() {
+		localCorpus, localErr = loadLocalCorpus(searchConf().LocalPath)
+	})
+	if localErr != nil {
+		return nil, localErr
+	}
+	return &localSearcher{docs: localCorpus}, nil
+}
+
+func loadLocalCorpus(path string) (docs []*localDoc, err error) {
+	if path == "" {
+		return nil, NoLocalCorpus
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var p Page
+		if err = sonic.UnmarshalString(line, &p); err != nil {
+			return nil, err
+		}
+		docs = append(docs, &localDoc{page: &p, name: segment.TermSet(p.Name),
+			terms: segment.TermSet(p.Snippet + " " + p.Summary)})
+	}
+	return docs, scanner.Err()
+}
+
+func (s *localSearcher) Search(_ context.Context, query string, opt *info.SearchOption) (*Result, error) {
+	terms := segment.QueryTerms(query)
+	type hit struct {
+		doc   *localDoc
+		score int
+	}
+	var hits []hit
+	for _, d := range s.docs {
+		if !allowPage(d.page, opt) {
+			continue
+		}
+		score := 0
+		for _, t := range terms {
+			if d.name[t] { // 标题命中权重更高
+				score += 2
+			}
+			if d.terms[t] {
+				score++
+			}
+		}
+		if score > 0 {
+			hits = append(hits, hit{doc: d, score: score})
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].score > hits[j].score })
+
+	r := &Result{Find: len(hits)}
+	for i := 0; i < len(hits) && i < searchCount(opt); i++ {
+		r.Pages = append(r.Pages, hits[i].doc.page)
+	}
+	return r, nil
+}
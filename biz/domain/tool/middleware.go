@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/eino/compose"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/interaction"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+)
+
+// EventMiddleware 工具调用中间件, 将每次调用的开始/参数/结果/结束写入事件流
+func EventMiddleware(st *state.RelayContext) compose.ToolMiddleware {
+	return compose.ToolMiddleware{
+		Invokable: func(next compose.InvokableToolEndpoint) compose.InvokableToolEndpoint {
+			return func(ctx context.Context, input *compose.ToolInput) (output *compose.ToolOutput, err error) {
+				if err = st.EventStream.Write(interaction.ToolStartEvent(input.CallID, input.Name)); err != nil {
+					return nil, err
+				}
+				if err = st.EventStream.Write(interaction.ToolArgsEvent(input.CallID, input.Name, input.Arguments)); err != nil {
+					return nil, err
+				}
+				if output, err = next(ctx, input); err != nil {
+					return nil, err
+				}
+				if err = st.EventStream.Write(interaction.ToolResultEvent(input.CallID, input.Name, output.Result)); err != nil {
+					return nil, err
+				}
+				if err = st.EventStream.Write(interaction.ToolEndEvent(input.CallID, input.Name)); err != nil {
+					return nil, err
+				}
+				return output, nil
+			}
+		},
+	}
+}
+
+// UnknownTool 模型调用了不存在的工具时, 将错误作为工具结果告知模型, 不中断对话
+func UnknownTool(_ context.Context, name, _ string) (string, error) {
+	return fmt.Sprintf("工具%s不存在, 请只使用提供的工具", name), nil
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	einotool "github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/event"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+)
+
+type failSearcher struct{}
+
+func (failSearcher) Search(context.Context, string, *info.SearchOption) (*Result, error) {
+	return nil, errors.New("search unavailable")
+}
+
+func TestToolErrorsDoNotFailTurn(t *testing.T) {
+	g := NewGomegaWithT(t)
+	st := &state.RelayContext{
+		Info:        &info.Info{ModelInfo: &info.ModelInfo{SearchOption: &info.SearchOption{}}, SearchInfo: &info.SearchInfo{}},
+		EventStream: event.NewEventStream(),
+	}
+	defer st.Close()
+	tn, err := compose.NewToolNode(context.Background(), &compose.ToolsNodeConfig{
+		Tools:               []einotool.BaseTool{&searchTool{st: st, searcher: failSearcher{}}, NewFetchTool()},
+		UnknownToolsHandler: UnknownTool,
+		ToolCallMiddlewares: []compose.ToolMiddleware{EventMiddleware(st)},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	call := func(id, name, args string) schema.ToolCall {
+		return schema.ToolCall{ID: id, Function: schema.FunctionCall{Name: name, Arguments: args}}
+	}
+	out, err := tn.Invoke(context.Background(), schema.AssistantMessage("", []schema.ToolCall{
+		call("1", WebSearchToolName, "{bad json"),
+		call("2", WebSearchToolName, `{"query":"天气"}`),
+		call("3", FetchToolName, "not json"),
+		call("4", "not_exist", "{}"),
+	}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(HaveLen(4))
+	g.Expect(out[0].Content).To(HavePrefix("搜索失败: 参数格式错误"))
+	g.Expect(out[1].Content).To(Equal("搜索失败: search unavailable"))
+	g.Expect(out[2].Content).To(HavePrefix("读取网页失败: 参数格式错误"))
+	g.Expect(out[3].Content).To(ContainSubstring("not_exist"))
+}
@@ -0,0 +1,151 @@
+package graph
+
+// tool.search.option 搜索配置, 合并用户配置与智能体默认策略
+
+import (
+	"math/rand/v2"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+)
+
+const (
+	DefaultSearchCount = 10 // 默认引用数量
+	MaxSearchCount     = 50 // 最大引用数量
+)
+
+var (
+	freshness = []string{"oneDay", "oneWeek", "oneMonth", "oneYear"}
+	dateRange = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(\.\.\d{4}-\d{2}-\d{2})?$`)
+)
+
+// ResolveSearchOption 合并用户搜索配置与智能体默认策略
+// 时间范围与数量以用户配置优先; 是否随机选取引用由配置决定, 智能体策略优先; 策略限定站点时, 用户只能在策略站点内进一步缩小范围; 禁止站点取并集
+func ResolveSearchOption(botId string, opt *info.SearchOption) *info.SearchOption {
+	c := searchConf()
+	opt = normalizeSearchOption(opt)
+	opt.Random = c.Random
+	policy, ok := c.Policies[botId]
+	if !ok || policy == nil {
+		return opt
+	}
+	if policy.Random != nil {
+		opt.Random = *policy.Random
+	}
+	if opt.Freshness == "" && validFreshness(policy.Freshness) {
+		opt.Freshness = policy.Freshness
+	}
+	if opt.Count == 0 && policy.Count > 0 {
+		opt.Count = min(policy.Count, MaxSearchCount)
+	}
+	if allowed := normalizeDomains(policy.Include); len(allowed) > 0 {
+		var include []string
+		for _, d := range opt.Include {
+			if domainMatch(d, allowed) {
+				include = append(include, d)
+			}
+		}
+		opt.Include = util.NilDefault(include, allowed) // 用户站点均不在策略内时使用策略站点
+	}
+	for _, d := range normalizeDomains(policy.Exclude) {
+		if !slices.Contains(opt.Exclude, d) {
+			opt.Exclude = append(opt.Exclude, d)
+		}
+	}
+	return opt
+}
+
+// normalizeSearchOption 校验用户配置, 非法的配置项视为未设置
+func normalizeSearchOption(opt *info.SearchOption) *info.SearchOption {
+	out := &info.SearchOption{}
+	if opt == nil {
+		return out
+	}
+	if validFreshness(opt.Freshness) {
+		out.Freshness = opt.Freshness
+	}
+	if opt.Count > 0 {
+		out.Count = min(opt.Count, MaxSearchCount)
+	}
+	out.Include, out.Exclude = normalizeDomains(opt.Include), normalizeDomains(opt.Exclude)
+	return out
+}
+
+func validFreshness(f string) bool {
+	return slices.Contains(freshness, f) || dateRange.MatchString(f)
+}
+
+// normalizeDomains 规范化站点, 支持直接填写URL
+func normalizeDomains(domains []string) (out []string) {
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if strings.Contains(d, "://") {
+			if u, err := url.Parse(d); err == nil {
+				d = u.Hostname()
+			}
+		}
+		d = strings.TrimPrefix(strings.TrimSuffix(d, "/"), "www.")
+		if d != "" && !slices.Contains(out, d) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// domainMatch 判断站点是否属于给定站点或其子域名
+func domainMatch(host string, domains []string) bool {
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	for _, d := range domains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowPage 判断网页是否满足站点限制, 搜索服务未完全遵守限制时兜底过滤
+func allowPage(p *Page, opt *info.SearchOption) bool {
+	if opt == nil || (len(opt.Include) == 0 && len(opt.Exclude) == 0) {
+		return true
+	}
+	u, err := url.Parse(p.URL)
+	if err != nil {
+		return false
+	}
+	host := u.Hostname()
+	if len(opt.Include) > 0 && !domainMatch(host, opt.Include) {
+		return false
+	}
+	return !domainMatch(host, opt.Exclude)
+}
+
+// searchCount 本次搜索的引用数量
+func searchCount(opt *info.SearchOption) int {
+	if opt == nil || opt.Count <= 0 {
+		return DefaultSearchCount
+	}
+	return opt.Count
+}
+
+// selectPages 选取前count篇网页作为引用; 开启随机选取时在前2*count篇中随机选取count篇, 选中的网页保持排名顺序
+func selectPages(pages []*Page, opt *info.SearchOption) []*Page {
+	n := searchCount(opt)
+	if len(pages) <= n {
+		return pages
+	}
+	if opt == nil || !opt.Random {
+		return pages[:n]
+	}
+	pool := pages[:min(len(pages), 2*n)]
+	picked := rand.Perm(len(pool))[:n]
+	slices.Sort(picked)
+	out := make([]*Page, n)
+	for i, k := range picked {
+		out[i] = pool[k]
+	}
+	return out
+}
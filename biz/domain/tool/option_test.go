@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+)
+
+func TestSelectPages(t *testing.T) {
+	pages := make([]*Page, 30)
+	for i := range pages {
+		pages[i] = &Page{URL: "https://example.com/" + strconv.Itoa(i)}
+	}
+	cases := []struct {
+		name  string
+		pages []*Page
+		opt   *info.SearchOption
+		want  []*Page
+	}{
+		{"default count", pages, nil, pages[:DefaultSearchCount]},
+		{"count", pages, &info.SearchOption{Count: 3}, pages[:3]},
+		{"fewer than count", pages[:2], &info.SearchOption{Count: 3, Random: true}, pages[:2]},
+		{"deterministic twice", pages, &info.SearchOption{Count: 5}, pages[:5]},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(selectPages(c.pages, c.opt)).To(Equal(c.want), c.name)
+	}
+}
+
+func TestSelectPagesRandom(t *testing.T) {
+	g := NewGomegaWithT(t)
+	pages := make([]*Page, 30)
+	index := map[*Page]int{}
+	for i := range pages {
+		pages[i] = &Page{URL: "https://example.com/" + strconv.Itoa(i)}
+		index[pages[i]] = i
+	}
+	opt := &info.SearchOption{Count: 5, Random: true}
+	for range 20 {
+		out := selectPages(pages, opt)
+		g.Expect(out).To(HaveLen(5))
+		for i, p := range out {
+			g.Expect(index[p]).To(BeNumerically("<", 10)) // 只在前2*count篇中选取
+			if i > 0 {
+				g.Expect(index[p]).To(BeNumerically(">", index[out[i-1]])) // 保持排名顺序
+			}
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package graph
+
+// tool.search.provider 搜索服务注册, 支持主备切换与结果缓存
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cache"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+)
+
+type getSearcherFunc func(ctx context.Context) (Searcher, error)
+
+var providers = map[string]getSearcherFunc{}
+var NoSuchProvider = errors.New("no such search provider")
+
+const searchCachePrefix = "inno:search:"
+
+var searchCache cache.Cmdable
+
+// RegisterProvider 注册搜索服务
+func RegisterProvider(name string, f getSearcherFunc) {
+	providers[name] = f
+}
+
+// InitSearchCache 设置搜索结果缓存
+func InitSearchCache(c cache.Cmdable) {
+	searchCache = c
+}
+
+// getSearcher 获取搜索服务
+func getSearcher(ctx context.Context, name string) (Searcher, error) {
+	fn, ok := providers[name]
+	if !ok {
+		return nil, NoSuchProvider
+	}
+	return fn(ctx)
+}
+
+// NewSearcher 根据配置构建搜索服务, 主备服务各自缓存后再包装为主备切换
+// 缓存键使用实际提供结果的服务, 避免备用服务的结果缓存在主服务名下
+func NewSearcher(ctx context.Context) (s Searcher, err error) {
+	c := searchConf()
+	if s, err = getSearcher(ctx, c.Primary); err != nil {
+		return nil, err
+	}
+	s = withCache(c, c.Primary, s)
+	if c.Fallback != "" && c.Fallback != c.Primary {
+		if fallback, err := getSearcher(ctx, c.Fallback); err != nil {
+			logs.Errorf("[search] get fallback provider %s err: %s", c.Fallback, err)
+		} else {
+			s = &failoverSearcher{primary: s, fallback: withCache(c, c.Fallback, fallback)}
+		}
+	}
+	return s, nil
+}
+
+// withCache 为搜索服务包装缓存, 未配置缓存时原样返回
+func withCache(c *conf.Search, provider string, s Searcher) Searcher {
+	if searchCache == nil || c.CacheTTL <= 0 {
+		return s
+	}
+	return &cachedSearcher{Searcher: s, provider: provider, ttl: time.Duration(c.CacheTTL) * time.Second}
+}
+
+func searchConf() *conf.Search {
+	if c := conf.GetConfig().Search; c != nil {
+		return c
+	}
+	return &conf.Search{Primary: Bocha, CacheTTL: 3600}
+}
+
+// failoverSearcher 主服务失败或没有结果时使用备用服务
+type failoverSearcher struct {
+	primary  Searcher
+	fallback Searcher
+}
+
+func (s *failoverSearcher) Search(ctx context.Context, query string, opt *info.SearchOption) (*Result, error) {
+	r, err := s.primary.Search(ctx, query, opt)
+	if err == nil && len(r.Pages) > 0 {
+		return r, nil
+	}
+	logs.CondErrorf(err != nil, "[search] primary provider err: %s, use fallback", err)
+	fr, ferr := s.fallback.Search(ctx, query, opt)
+	switch {
+	case ferr == nil:
+		return fr, nil
+	case err == nil: // 主服务无结果且备用服务失败
+		return r, nil
+	default:
+		return nil, errors.Join(err, ferr)
+	}
+}
+
+// cachedSearcher 缓存规范化后搜索词的结果, 避免重复提问消耗搜索额度
+type cachedSearcher struct {
+	Searcher
+	provider string
+	ttl      time.Duration
+}
+
+func (s *cachedSearcher) Search(ctx context.Context, query string, opt *info.SearchOption) (r *Result, err error) {
+	key := s.key(query, opt)
+	if data, err := searchCache.Get(ctx, key).Result(); err == nil {
+		if err = sonic.UnmarshalString(data, &r); err == nil {
+			return r, nil
+		}
+	} else if !errors.Is(err, cache.Nil) {
+		logs.Errorf("[search] get cache err: %s", err)
+	}
+
+	if r, err = s.Searcher.Search(ctx, query, opt); err != nil {
+		return nil, err
+	}
+	if len(r.Pages) > 0 { // 空结果不缓存
+		if data, err := sonic.MarshalString(r); err == nil {
+			if err = searchCache.Set(ctx, key, data, s.ttl).Err(); err != nil {
+				logs.Errorf("[search] set cache err: %s", err)
+			}
+		}
+	}
+	return r, nil
+}
+
+// key 缓存键由规范化后的搜索词与搜索配置共同决定
+func (s *cachedSearcher) key(query string, opt *info.SearchOption) string {
+	raw := normalizeQuery(query)
+	if opt != nil {
+		raw += "|" + opt.Freshness + "|" + strings.Join(opt.Include, ",") + "|" + strings.Join(opt.Exclude, ",") + "|" + strconv.Itoa(opt.Count)
+	}
+	sum := md5.Sum([]byte(raw))
+	return searchCachePrefix + s.provider + ":" + hex.EncodeToString(sum[:])
+}
+
+// normalizeQuery 规范化搜索词, 忽略大小写与多余空白
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
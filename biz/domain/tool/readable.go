@@ -0,0 +1,175 @@
+package graph
+
+// tool.fetch.readable 从HTML中提取正文, 并按token预算切分
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// 不包含正文的标签
+var skipTags = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Template: true, atom.Svg: true,
+	atom.Iframe: true, atom.Form: true, atom.Button: true, atom.Select: true, atom.Input: true,
+	atom.Nav: true, atom.Header: true, atom.Footer: true, atom.Aside: true,
+}
+
+// 块级标签, 前后需要换行
+var blockTags = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true, atom.Main: true, atom.Br: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Li: true, atom.Ul: true, atom.Ol: true, atom.Tr: true, atom.Table: true, atom.Blockquote: true,
+	atom.Pre: true, atom.Dd: true, atom.Dt: true, atom.Figcaption: true,
+}
+
+const minMainTextLen = 200 // 正文容器的最小文本长度, 过短时认为不是正文
+
+// Readable 网页正文
+type Readable struct {
+	Title string
+	Text  string
+}
+
+// ExtractReadable 解析HTML并提取正文
+// 优先使用article/main等正文容器, 没有时使用body中去除导航、页眉页脚等内容后的文本
+func ExtractReadable(r io.Reader) (*Readable, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	rd := &Readable{}
+	var body, main *html.Node
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.DataAtom {
+			case atom.Title:
+				if rd.Title == "" && n.FirstChild != nil {
+					rd.Title = strings.TrimSpace(n.FirstChild.Data)
+				}
+			case atom.Body:
+				body = n
+			case atom.Article, atom.Main:
+				if main == nil && utf8.RuneCountInString(nodeText(n)) >= minMainTextLen {
+					main = n
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	switch {
+	case main != nil:
+		rd.Text = nodeText(main)
+	case body != nil:
+		rd.Text = nodeText(body)
+	default:
+		rd.Text = nodeText(doc)
+	}
+	return rd, nil
+}
+
+// nodeText 提取节点下的文本, 块级元素之间换行, 行内空白合并
+func nodeText(n *html.Node) string {
+	var sb strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			sb.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			if skipTags[n.DataAtom] {
+				return
+			}
+			if blockTags[n.DataAtom] {
+				sb.WriteString("\n")
+				defer sb.WriteString("\n")
+			}
+		case html.CommentNode:
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return normalizeText(sb.String())
+}
+
+// normalizeText 合并每行中的空白, 去除空行
+func normalizeText(s string) string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ChunkText 按段落将文本切分为不超过budget个token的片段, 超长段落按字符切分
+func ChunkText(text string, budget int) (chunks []string) {
+	if budget <= 0 {
+		return []string{text}
+	}
+	var sb strings.Builder
+	tokens := 0
+	flush := func() {
+		if sb.Len() > 0 {
+			chunks = append(chunks, sb.String())
+			sb.Reset()
+			tokens = 0
+		}
+	}
+	for _, para := range strings.Split(text, "\n") {
+		for _, piece := range splitByTokens(para, budget) {
+			t := util.EstimateTokens(piece)
+			if tokens+t > budget {
+				flush()
+			}
+			if sb.Len() > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(piece)
+			tokens += t
+		}
+	}
+	flush()
+	return chunks
+}
+
+// splitByTokens 将超出预算的单个段落切分
+func splitByTokens(para string, budget int) (pieces []string) {
+	if util.EstimateTokens(para) <= budget {
+		return []string{para}
+	}
+	rs := []rune(para)
+	start := 0
+	for start < len(rs) {
+		end, cjk, other := start, 0, 0
+		for ; end < len(rs); end++ {
+			if util.IsCJK(rs[end]) {
+				cjk++
+			} else {
+				other++
+			}
+			if cjk+(other+3)/4 > budget {
+				break
+			}
+		}
+		if end == start {
+			end++
+		}
+		pieces = append(pieces, string(rs[start:end]))
+		start = end
+	}
+	return pieces
+}
@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+)
+
+func TestChunkText(t *testing.T) {
+	cjk := func(n int) string { return strings.Repeat("字", n) }
+	cases := []struct {
+		name   string
+		text   string
+		budget int
+		want   []string
+	}{
+		{"no budget", "a\nb", 0, []string{"a\nb"}},
+		{"empty", "", 5, nil},
+		{"single chunk", "a\n\nb", 10, []string{"a\n\nb"}},
+		{"merge paragraphs", cjk(2) + "\n" + cjk(2) + "\n" + cjk(2), 5, []string{cjk(2) + "\n" + cjk(2), cjk(2)}},
+		{"split long paragraph", cjk(12) + "\nab", 5, []string{cjk(5), cjk(5), cjk(2) + "\nab"}},
+		{"split ascii", "abcdefghijkl", 2, []string{"abcdefgh", "ijkl"}},
+		{"paragraph exactly fits", cjk(5) + "\n" + cjk(1), 5, []string{cjk(5), cjk(1)}},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		chunks := ChunkText(c.text, c.budget)
+		g.Expect(chunks).To(Equal(c.want), c.name)
+		if c.budget > 0 {
+			for _, chunk := range chunks {
+				g.Expect(util.EstimateTokens(chunk)).To(BeNumerically("<=", c.budget), c.name)
+			}
+		}
+	}
+}
+
+func TestSplitByTokens(t *testing.T) {
+	cases := []struct {
+		name   string
+		para   string
+		budget int
+		want   []string
+	}{
+		{"fits", "字字", 2, []string{"字字"}},
+		{"cjk", "字字字字字", 2, []string{"字字", "字字", "字"}},
+		{"mixed", "ab字字cd", 2, []string{"ab字", "字cd"}},
+		{"rune wider than budget", "字", 0, []string{"字"}},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(splitByTokens(c.para, c.budget)).To(Equal(c.want), c.name)
+	}
+}
@@ -2,62 +2,268 @@ package graph
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/cloudwego/eino/components/prompt"
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/schema"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/interaction"
 	"github.com/xh-polaris/innospark-core-api/biz/domain/state"
+	"github.com/xh-polaris/innospark-core-api/biz/domain/state/info"
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
 	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
 )
 
+const (
+	WebSearchToolName = "web_search"
+	MaxQueries        = 3 // 单次搜索最多搜索词数
+)
+
+var NoSearchResult = errors.New("no search result")
+
 type WebSearchTool interface {
 	Info(_ context.Context) (*schema.ToolInfo, error)
 	InvokableRun(ctx context.Context, jsonStr string, _ ...tool.Option) (_ string, err error)
 }
 
-func NewSearchTool(provider string, relay *state.RelayContext, apiKey string) WebSearchTool {
-	switch provider {
-	case "bocha":
-		return NewBochaSearchTool(relay, apiKey)
+// Searcher 搜索服务, 只负责单个搜索词的检索, 需尽量遵守搜索配置
+type Searcher interface {
+	Search(ctx context.Context, query string, opt *info.SearchOption) (*Result, error)
+}
+
+// Result 单个搜索词的搜索结果, 网页按相关度排序
+type Result struct {
+	Find  int     `json:"find"`  // 找到的数量
+	Pages []*Page `json:"pages"` // 网页
+}
+
+// Page 搜索到的网页
+type Page struct {
+	Name          string `json:"name"`                    // 网页标题
+	URL           string `json:"url"`                     // 网页URL
+	Snippet       string `json:"snippet,omitempty"`       // 简短描述
+	Summary       string `json:"summary,omitempty"`       // 总结
+	SiteName      string `json:"siteName,omitempty"`      // 站点名称
+	SiteIcon      string `json:"siteIcon,omitempty"`      // 站点图标
+	DatePublished string `json:"datePublished,omitempty"` // 发布时间
+	Content       string `json:"-"`                       // 读取到的网页正文, 不缓存
+}
+
+// searchTool 供模型调用的联网搜索工具, 实际搜索由配置的搜索服务完成
+type searchTool struct {
+	st       *state.RelayContext
+	searcher Searcher
+}
+
+// NewSearchTool 根据配置的搜索服务构建联网搜索工具
+func NewSearchTool(ctx context.Context, relay *state.RelayContext) (WebSearchTool, error) {
+	searcher, err := NewSearcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &searchTool{st: relay, searcher: searcher}, nil
+}
+
+func (t *searchTool) Info(_ context.Context) (*schema.ToolInfo, error) {
+	return &schema.ToolInfo{
+		Name: WebSearchToolName,
+		Desc: "联网搜索, 当问题涉及实时信息、新闻事件或需要查证的事实时使用",
+		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+			"query": {Type: schema.String, Desc: "搜索词, 需要是脱离上下文也能理解的完整问题", Required: true},
+			"queries": {Type: schema.Array, Desc: "问题涉及多个方面时, 额外的独立搜索词, 会与query并发搜索",
+				ElemInfo: &schema.ParameterInfo{Type: schema.String}},
+		}),
+	}, nil
+}
+
+func (t *searchTool) InvokableRun(ctx context.Context, jsonStr string, _ ...tool.Option) (_ string, err error) {
+	var args struct {
+		Query   string   `json:"query"`
+		Queries []string `json:"queries"`
+	}
+	if err = json.Unmarshal([]byte(jsonStr), &args); err != nil {
+		return fmt.Sprintf("搜索失败: 参数格式错误, %s", err), nil
 	}
-	return nil
+	queries := normalizeQueries(append([]string{args.Query}, args.Queries...))
+	result, err := MultiSearch(ctx, t.st, t.searcher, queries)
+	if errors.Is(err, NoSearchResult) {
+		return "没有搜索到相关结果", nil
+	} else if err != nil {
+		// 搜索失败时告知模型原因, 由模型决定是否换用其他搜索词或直接回答, 不中断对话
+		logs.CtxErrorf(ctx, "[search] search %v err: %s", queries, errorx.ErrorWithoutStack(err))
+		return fmt.Sprintf("搜索失败: %s", errorx.ErrorWithoutStack(err)), nil
+	}
+	return result, nil
 }
 
-func Search(ctx context.Context, provider, apiKey, template string, input []*schema.Message) (_ []*schema.Message, err error) {
-	var relay *state.RelayContext
-	if relay, err = util.GetState[*state.RelayContext](ctx); err != nil {
-		return
+// MultiSearch 并发搜索多个搜索词, 按URL去重合并后排序, 作为一组引用写入事件流
+// 已有引用时编号顺延, 保证同一次回答中引用编号唯一
+func MultiSearch(ctx context.Context, st *state.RelayContext, searcher Searcher, queries []string) (_ string, err error) {
+	if searcher == nil || len(queries) == 0 {
+		return "", NoSearchResult
 	}
-	// 搜索, 过程中会给前端对应反馈
-	search := NewSearchTool(provider, relay, apiKey)
-	var result string
-	// Mock了模型的function call
-	if result, err = search.InvokableRun(ctx, fmt.Sprintf("{\"query\":\"%s\"}", relay.Info.OriginMessage.Content)); err != nil {
-		return nil, err
+	if err = st.EventStream.Write(interaction.SearchStartEvent()); err != nil { // 开始搜索
+		return "", err
+	}
+
+	// 并发搜索, 部分搜索词失败时使用其余结果
+	opt := st.Info.ModelInfo.SearchOption
+	results, errs := make([]*Result, len(queries)), make([]error, len(queries))
+	var wg sync.WaitGroup
+	for i, q := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = searcher.Search(ctx, q, opt)
+		}()
+	}
+	wg.Wait()
+
+	inf := st.Info.SearchInfo
+	inf.Lock()
+	inf.Calls += len(queries)
+	pages, find := mergeResults(results, inf.Cite, opt)
+	inf.Unlock()
+	if len(pages) == 0 {
+		for _, e := range errs {
+			if e != nil {
+				return "", e
+			}
+		}
+	}
+	for i, e := range errs {
+		logs.CondErrorf(e != nil, "[search] query %s err: %s", queries[i], e)
+	}
+
+	// SSE: 查找多少篇?
+	if err = st.EventStream.Write(interaction.SearchFindEvent(find)); err != nil {
+		return "", err
+	}
+	// 按排序选取前count篇, 未开启随机选取时相同结果的选择是确定的
+	pages = selectPages(pages, opt)
+	// SSE: 选择多少篇
+	if err = st.EventStream.Write(interaction.SearchChooseEvent(len(pages))); err != nil {
+		return "", err
+	}
+	// 读取排名靠前的网页正文, 补充摘要信息的不足
+	if k := fetchConf().AutoTopK; k > 0 {
+		FetchPages(ctx, pages, k)
+	}
+
+	// 处理结果, 大模型只需要知道cite编号和summary内容, summary内容暂时选择不存储
+	// index, name, url, snippet, siteName. siteIcon, datePublished都需要给前端
+	// 编号在锁内分配, 同一轮中并发的搜索不会得到重复的编号, 事件在锁外写入, 避免阻塞交互域
+	var sb strings.Builder
+	cites := make([]*mmsg.Cite, 0, len(pages))
+	inf.Lock()
+	offset := len(inf.Cite)
+	for i, v := range pages {
+		idx := offset + i
+		sb.WriteString("索引:")
+		sb.WriteString(strconv.Itoa(idx))
+		sb.WriteString(strings.Replace(util.ZeroDefault(v.Content, util.ZeroDefault(v.Summary, v.Snippet)), "\n", " ", -1))
+		sb.WriteString("\n")
+		c := &mmsg.Cite{Index: int32(idx), Name: v.Name, URL: v.URL, Snippet: strings.Replace(v.Snippet, "\n", " ", -1),
+			SiteName: v.SiteName, SiteIcon: v.SiteIcon, DatePublished: v.DatePublished}
+		inf.Cite, cites = append(inf.Cite, c), append(cites, c)
+	}
+	inf.Find, inf.Choose = inf.Find+find, len(inf.Cite)
+	inf.Unlock()
+	for _, c := range cites { // SSE: 返回引用
+		if err = st.EventStream.Write(interaction.SearchCiteEvent(c)); err != nil {
+			return "", err
+		}
+	}
+	if err = st.EventStream.Write(interaction.SearchEndEvent()); err != nil {
+		return "", err
+	}
+	if len(pages) == 0 {
+		return "", NoSearchResult
+	}
+	return sb.String(), nil
+}
+
+// mergeResults 按URL去重合并多个搜索词的结果, 排除已引用及不满足站点限制的网页
+// 排序采用倒数排名融合, 被多个搜索词命中且排名靠前的网页优先
+func mergeResults(results []*Result, cited []*mmsg.Cite, opt *info.SearchOption) (pages []*Page, find int) {
+	seen := make(map[string]bool, len(cited))
+	for _, c := range cited {
+		seen[c.URL] = true
+	}
+	score, order := map[string]float64{}, map[string]int{}
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		find += r.Find
+		for rank, p := range r.Pages {
+			if p.URL == "" || seen[p.URL] || !allowPage(p, opt) {
+				continue
+			}
+			if _, ok := score[p.URL]; !ok {
+				order[p.URL] = len(pages)
+				pages = append(pages, p)
+			}
+			score[p.URL] += 1 / float64(rank+rrfK)
+		}
 	}
+	sort.SliceStable(pages, func(i, j int) bool {
+		si, sj := score[pages[i].URL], score[pages[j].URL]
+		if si != sj {
+			return si > sj
+		}
+		return order[pages[i].URL] < order[pages[j].URL]
+	})
+	return pages, find
+}
 
+const rrfK = 60 // 倒数排名融合常数
+
+// templateSearchTool 用模板包装搜索结果, 引导模型按索引引用
+type templateSearchTool struct {
+	WebSearchTool
+	st       *state.RelayContext
+	template string
+}
+
+// WithTemplate 为搜索工具的结果套用模板
+func WithTemplate(t WebSearchTool, relay *state.RelayContext, template string) WebSearchTool {
+	return &templateSearchTool{WebSearchTool: t, st: relay, template: template}
+}
+
+func (t *templateSearchTool) InvokableRun(ctx context.Context, jsonStr string, opts ...tool.Option) (_ string, err error) {
+	var result string
+	if result, err = t.WebSearchTool.InvokableRun(ctx, jsonStr, opts...); err != nil {
+		return "", err
+	}
 	// 填充模板
-	format, err := prompt.FromMessages(schema.FString, &schema.Message{Role: "user", Content: template}).Format(ctx,
-		map[string]any{"searchContent": result, "query": relay.Info.OriginMessage.Content})
+	format, err := prompt.FromMessages(schema.FString, &schema.Message{Role: "user", Content: t.template}).Format(ctx,
+		map[string]any{"searchContent": result, "query": t.st.Info.OriginMessage.Content})
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	return format[0].Content, nil
+}
 
-	cite := format[0].Content
-	// 找到最近一条有效的用户消息, 替换为带引用内容
-	for _, m := range input {
-		if m.Role == schema.User {
-			if m.Content != "" {
-				m.Content = cite
-			} else if len(m.UserInputMultiContent) != 0 {
-				for i := range m.UserInputMultiContent {
-					if m.UserInputMultiContent[i].Type == schema.ChatMessagePartTypeText {
-						m.UserInputMultiContent[i].Text = cite
-					}
-				}
-			}
+// normalizeQueries 去除空白与重复的搜索词, 并限制数量
+func normalizeQueries(queries []string) (out []string) {
+	seen := map[string]bool{}
+	for _, q := range queries {
+		if q = strings.TrimSpace(q); q == "" || seen[q] {
+			continue
+		}
+		seen[q] = true
+		if out = append(out, q); len(out) >= MaxQueries {
+			break
 		}
 	}
-	return input, nil
+	return out
 }
@@ -40,6 +40,13 @@ const (
 	EventError          = "error"
 	EventExtractInfo    = "extractInfo"
 	EventExtractInfoEnd = "extractInfoEnd"
+	EventToolStart      = "toolStart"
+	EventToolArgs       = "toolArgs"
+	EventToolResult     = "toolResult"
+	EventToolEnd        = "toolEnd"
+	EventCiteUsed       = "citeUsed"
+	EventTitle          = "title"
+	EventQuota          = "quota"
 )
 
 // Event中各种类型枚举值
@@ -95,6 +102,42 @@ const (
 	Action         = "action"
 	Type           = "type"
 	Ext            = "ext"
+	Summary        = "summary"
+	Content        = "content"
+	Sections       = "sections"
+	SectionId      = "section_id"
+	ParentId       = "parent_id"
+	ReplyId        = "reply_id"
+	Index          = "index"
+	Inactive       = "inactive"
+	Token          = "token"
+	Views          = "views"
+	Messages       = "messages"
+	Terms          = "terms"
+	ThinkTerms     = "think_terms"
+	Pinned         = "pinned"
+	FolderId       = "folder_id"
+	Tags           = "tags"
+	TrashStatus    = "trash_status"
+	Renamed        = "renamed"
+	TitleMessageId = "title_mid"
+	Hash           = "hash"
+	Models         = "models"
+	Quota          = "quota"
+	Used           = "used"
+	LastUsedTime   = "last_used_time"
+	Model          = "model"
+	BotId          = "bot_id"
+	Date           = "date"
+	Key            = "key"
+	Requests       = "requests"
+	PromptTokens   = "prompt_tokens"
+	CompleteTokens = "completion_tokens"
+	CachedTokens   = "cached_tokens"
+	TotalTokens    = "total_tokens"
+	SearchCalls    = "search_calls"
+	OCRCalls       = "ocr_calls"
+	Latency        = "latency"
 
 	Status        = "status"
 	DeletedStatus = -1
@@ -103,13 +146,34 @@ const (
 	Score         = "score"
 	NE            = "$ne"
 	LT            = "$lt"
+	In            = "$in"
 	LTE           = "$lte"
 	GTE           = "$gte"
 	Set           = "$set"
+	Inc           = "$inc"
+	Unset         = "$unset"
+	Push          = "$push"
 	Text          = "$text"
 	Search        = "$search"
 	Regex         = "$regex"
 	Options       = "$options"
+	All           = "$all"
+	Or            = "$or"
+	And           = "$and"
+	Exists        = "$exists"
+	Match         = "$match"
+	Sort          = "$sort"
+	Limit         = "$limit"
+	Lookup        = "$lookup"
+	Project       = "$project"
+	Group         = "$group"
+	Sum           = "$sum"
+	ToString      = "$toString"
+	Unwind        = "$unwind"
+	UnionWith     = "$unionWith"
+	DateToString  = "$dateToString"
+	Cond          = "$cond"
+	Eq            = "$eq"
 
 	Name    = "name"
 	Avatar  = "avatar"
@@ -136,4 +200,5 @@ const (
 	Regen       = "regen"
 	Replace     = "replace"
 	SelectRegen = "select_regen"
+	Edit        = "edit"
 )
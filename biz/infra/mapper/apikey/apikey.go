@@ -0,0 +1,87 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	KeyPrefix     = "sk-inno-" // 密钥前缀, 用于区分密钥与JWT
+	displayLength = 12         // 展示时保留的密钥长度
+)
+
+// APIKey 用户的API密钥, 只保存密钥的哈希, 明文只在创建时返回一次
+type APIKey struct {
+	KeyId        primitive.ObjectID `json:"key_id" bson:"_id"`                                        // 主键
+	UserId       primitive.ObjectID `json:"user_id" bson:"user_id"`                                   // 用户id, 索引
+	Name         string             `json:"name" bson:"name"`                                         // 密钥名称
+	Hash         string             `json:"-" bson:"hash"`                                            // 密钥的sha256哈希, 唯一索引
+	Display      string             `json:"display" bson:"display"`                                   // 密钥的前几位, 用于展示
+	Models       []string           `json:"models,omitempty" bson:"models,omitempty"`                 // 允许调用的模型, 为空时不限制
+	Quota        int64              `json:"quota" bson:"quota"`                                       // token配额, 0表示不限制
+	Used         int64              `json:"used" bson:"used"`                                         // 已使用的token数
+	LastUsedTime time.Time          `json:"last_used_time,omitempty" bson:"last_used_time,omitempty"` // 最近使用时间
+	CreateTime   time.Time          `json:"create_time" bson:"create_time"`                           // 创建时间
+	UpdateTime   time.Time          `json:"update_time" bson:"update_time"`                           // 更新时间
+	DeleteTime   time.Time          `json:"delete_time,omitempty" bson:"delete_time,omitempty"`       // 撤销时间
+	Status       int32              `json:"status" bson:"status"`                                     // 状态, 撤销后为删除状态
+}
+
+// UpdateOption 密钥的更新项, 为nil的项不更新
+type UpdateOption struct {
+	Name   *string
+	Models []string // 非nil时整体替换, 空数组表示不限制
+	Quota  *int64
+}
+
+// NewKey 生成密钥明文, 前缀加192位随机数的URL安全编码
+func NewKey() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return KeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// IsKey 是否为API密钥格式
+func IsKey(s string) bool {
+	return strings.HasPrefix(s, KeyPrefix)
+}
+
+// HashKey 密钥的哈希, 密钥本身是高熵随机数, 无需加盐
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// DisplayOf 密钥用于展示的部分
+func DisplayOf(key string) string {
+	if len(key) > displayLength {
+		return key[:displayLength] + "..."
+	}
+	return key
+}
+
+// Allow 密钥是否允许调用模型
+func (k *APIKey) Allow(model string) bool {
+	if len(k.Models) == 0 {
+		return true
+	}
+	for _, m := range k.Models {
+		if m == model {
+			return true
+		}
+	}
+	return false
+}
+
+// Exhausted 配额是否已用尽
+func (k *APIKey) Exhausted() bool {
+	return k.Quota > 0 && k.Used >= k.Quota
+}
@@ -0,0 +1,168 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/basic"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var Mapper MongoMapper = (*mongoMapper)(nil)
+
+const (
+	collection = "apikey"
+	touchGap   = time.Minute // 最近使用时间的更新间隔, 避免每次请求都写库
+)
+
+type MongoMapper interface {
+	Insert(ctx context.Context, k *APIKey) error
+	GetByHash(ctx context.Context, hash string) (k *APIKey, err error)
+	ListKeys(ctx context.Context, uid string, page *basic.Page) (ks []*APIKey, hasMore bool, err error)
+	CountKeys(ctx context.Context, uid string) (int64, error)
+	Update(ctx context.Context, uid, kid string, opt *UpdateOption) error
+	Revoke(ctx context.Context, uid, kid string) error
+	Touch(ctx context.Context, k *APIKey) error
+	AddUsed(ctx context.Context, kid primitive.ObjectID, tokens int64) error
+}
+
+type mongoMapper struct {
+	conn *monc.Model
+}
+
+func NewAPIKeyMongoMapper(config *conf.Config) MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, collection, config.CacheConf)
+	Mapper = &mongoMapper{conn: conn}
+	return Mapper
+}
+
+// Insert 新增一个密钥
+func (m *mongoMapper) Insert(ctx context.Context, k *APIKey) (err error) {
+	now := time.Now()
+	if k.KeyId.IsZero() {
+		k.KeyId = primitive.NewObjectID()
+	}
+	k.CreateTime, k.UpdateTime = now, now
+	_, err = m.conn.InsertOneNoCache(ctx, k)
+	return err
+}
+
+// GetByHash 根据密钥哈希获取未撤销的密钥
+func (m *mongoMapper) GetByHash(ctx context.Context, hash string) (k *APIKey, err error) {
+	k = &APIKey{}
+	err = m.conn.FindOneNoCache(ctx, k, bson.M{cst.Hash: hash, cst.Status: bson.M{cst.NE: cst.DeletedStatus}})
+	return k, err
+}
+
+// ListKeys 分页查询用户未撤销的密钥, 按创建时间倒序
+func (m *mongoMapper) ListKeys(ctx context.Context, uid string, page *basic.Page) (ks []*APIKey, hasMore bool, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [apikey] [ListKeys] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, false, err
+	}
+
+	opts := options.Find().SetSort(bson.M{cst.Id: -1}).SetLimit(page.GetSize() + 1)
+	filter := bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	if page != nil && page.Cursor != nil { // 存在cursor时, 查询创建时间小于Cursor的
+		cursor, err := primitive.ObjectIDFromHex(*page.Cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		filter[cst.Id] = bson.M{cst.LT: cursor}
+	}
+	if err = m.conn.Find(ctx, &ks, filter, opts); err != nil {
+		return nil, false, err
+	}
+	ks, hasMore = util.SplitAndHasMore(ks, page)
+	return ks, hasMore, err
+}
+
+// CountKeys 用户未撤销的密钥数量
+func (m *mongoMapper) CountKeys(ctx context.Context, uid string) (int64, error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [apikey] [CountKeys] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return 0, err
+	}
+	return m.conn.CountDocuments(ctx, bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}})
+}
+
+// Update 更新用户未撤销的密钥的名称、模型范围或配额
+func (m *mongoMapper) Update(ctx context.Context, uid, kid string, opt *UpdateOption) (err error) {
+	filter, err := ownFilter(uid, kid)
+	if err != nil {
+		logs.Errorf("[mapper] [apikey] [Update] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	update := bson.M{cst.UpdateTime: time.Now()}
+	if opt.Name != nil {
+		update[cst.Name] = *opt.Name
+	}
+	if opt.Models != nil {
+		update[cst.Models] = opt.Models
+	}
+	if opt.Quota != nil {
+		update[cst.Quota] = *opt.Quota
+	}
+	res, err := m.conn.UpdateOneNoCache(ctx, filter, bson.M{cst.Set: update})
+	if err == nil && res.MatchedCount == 0 {
+		return monc.ErrNotFound
+	}
+	return err
+}
+
+// Revoke 撤销用户的密钥, 撤销后立即不可用
+func (m *mongoMapper) Revoke(ctx context.Context, uid, kid string) (err error) {
+	filter, err := ownFilter(uid, kid)
+	if err != nil {
+		logs.Errorf("[mapper] [apikey] [Revoke] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	res, err := m.conn.UpdateOneNoCache(ctx, filter,
+		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.DeleteTime: time.Now(), cst.Status: cst.DeletedStatus}})
+	if err == nil && res.MatchedCount == 0 {
+		return monc.ErrNotFound
+	}
+	return err
+}
+
+// Touch 更新最近使用时间, 距上次更新不足touchGap时跳过
+func (m *mongoMapper) Touch(ctx context.Context, k *APIKey) (err error) {
+	now := time.Now()
+	if now.Sub(k.LastUsedTime) < touchGap {
+		return nil
+	}
+	k.LastUsedTime = now
+	_, err = m.conn.UpdateOneNoCache(ctx, bson.M{cst.Id: k.KeyId}, bson.M{cst.Set: bson.M{cst.LastUsedTime: now}})
+	return err
+}
+
+// AddUsed 累加密钥已使用的token数
+func (m *mongoMapper) AddUsed(ctx context.Context, kid primitive.ObjectID, tokens int64) (err error) {
+	if tokens <= 0 {
+		return nil
+	}
+	_, err = m.conn.UpdateOneNoCache(ctx, bson.M{cst.Id: kid}, bson.M{cst.Inc: bson.M{cst.Used: tokens}})
+	return err
+}
+
+func ownFilter(uid, kid string) (bson.M, error) {
+	uoid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return nil, err
+	}
+	koid, err := primitive.ObjectIDFromHex(kid)
+	if err != nil {
+		return nil, err
+	}
+	return bson.M{cst.Id: koid, cst.UserId: uoid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}, nil
+}
@@ -18,4 +18,52 @@ type Conversation struct {
 	UpdateTime     time.Time          `json:"update_time" bson:"update_time"`                     // 更新时间
 	DeleteTime     time.Time          `json:"delete_time,omitempty" bson:"delete_time,omitempty"` // 删除时间
 	Status         int32              `json:"status" bson:"status"`                               // 状态
+	Summary        *Summary           `json:"summary,omitempty" bson:"summary,omitempty"`         // 对话摘要
+	Sections       []*Section         `json:"sections,omitempty" bson:"sections,omitempty"`       // 新建的段落
+	Pinned         bool               `json:"pinned,omitempty" bson:"pinned,omitempty"`           // 是否置顶, 取消置顶时移除该字段
+	FolderId       primitive.ObjectID `json:"folder_id,omitempty" bson:"folder_id,omitempty"`     // 所属文件夹id
+	Tags           []string           `json:"tags,omitempty" bson:"tags,omitempty"`               // 标签
+	Renamed        bool               `json:"renamed,omitempty" bson:"renamed,omitempty"`         // 用户是否重命名过, 重命名后不再自动生成标题
+	TitleMessageId primitive.ObjectID `json:"title_mid,omitempty" bson:"title_mid,omitempty"`     // 上次生成或检查标题时的用户消息id
+}
+
+// DefaultBrief 新建对话的默认标题
+const DefaultBrief = "未命名对话"
+
+// ListOption 对话列表的筛选与排序条件, 为空时按创建时间倒序返回全部对话
+type ListOption struct {
+	FolderId    *primitive.ObjectID // 只返回该文件夹中的对话, 为零值时只返回未归档的对话
+	Tag         string              // 只返回带有该标签的对话
+	Pinned      *bool               // 只返回置顶或未置顶的对话
+	SortBy      string              // 排序字段, create_time/update_time
+	PinnedFirst bool                // 置顶的对话排在前面
+}
+
+// Section 段落, 新建段落后此前的消息不再作为上下文
+type Section struct {
+	SectionId  primitive.ObjectID `json:"section_id" bson:"section_id"`   // 段落id
+	StartIndex int32              `json:"start_index" bson:"start_index"` // 段落第一条消息的索引
+	CreateTime time.Time          `json:"create_time" bson:"create_time"` // 创建时间
+}
+
+// CurrentSection 当前段落, 未新建过段落时为对话的第一个段落, 其id与对话id相同
+func (c *Conversation) CurrentSection() *Section {
+	if len(c.Sections) > 0 {
+		return c.Sections[len(c.Sections)-1]
+	}
+	return &Section{SectionId: c.ConversationId, CreateTime: c.CreateTime}
+}
+
+// SectionList 对话的全部段落, 按创建时间正序
+func (c *Conversation) SectionList() []*Section {
+	return append([]*Section{{SectionId: c.ConversationId, CreateTime: c.CreateTime}}, c.Sections...)
+}
+
+// Summary 对话较早部分的摘要, 用于替代上下文中被裁剪的历史记录
+type Summary struct {
+	SectionId    primitive.ObjectID `json:"section_id" bson:"section_id"`                             // 摘要所属的段落id
+	Content      string             `json:"content" bson:"content"`                                   // 摘要内容
+	EndIndex     int32              `json:"end_index" bson:"end_index"`                               // 摘要覆盖的最后一条消息索引, 索引不大于该值的消息均已摘要
+	EndMessageId primitive.ObjectID `json:"end_message_id,omitempty" bson:"end_message_id,omitempty"` // 摘要覆盖的最后一条消息id, 用于判断摘要是否属于当前分支
+	UpdateTime   time.Time          `json:"update_time" bson:"update_time"`                           // 更新时间
 }
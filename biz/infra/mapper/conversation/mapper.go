@@ -2,6 +2,10 @@ package conversation
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/xh-polaris/innospark-core-api/biz/application/dto/basic"
@@ -13,6 +17,7 @@ import (
 	"github.com/zeromicro/go-zero/core/stores/monc"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
@@ -23,14 +28,30 @@ const (
 	cacheKeyPrefix = "cache:conversation:"
 )
 
+var errInvalidCursor = errors.New("invalid conversation cursor")
+
 type MongoMapper interface {
 	CreateNewConversation(ctx context.Context, uid, botId string) (c *Conversation, err error)
+	InsertConversation(ctx context.Context, c *Conversation) (err error)
 	GetConversation(ctx context.Context, cid string) (c *Conversation, err error)
 	UpdateConversationExt(ctx context.Context, cid string, ext map[string]string) error
-	ListConversations(ctx context.Context, uid string, page *basic.Page) (cs []*Conversation, hasMore bool, err error)
+	UpdateConversationSummary(ctx context.Context, cid string, summary *Summary) error
+	NewSection(ctx context.Context, uid, cid string, start int32) (s *Section, err error)
+	ListConversations(ctx context.Context, uid string, opt *ListOption, page *basic.Page) (cs []*Conversation, hasMore bool, err error)
+	ListAllConversations(ctx context.Context, uid string) (cs []*Conversation, err error)
 	UpdateConversationBrief(ctx context.Context, uid, cid, brief string) (err error)
+	UpdateConversationTitle(ctx context.Context, cid, brief string, mid primitive.ObjectID) (ok bool, err error)
 	DeleteConversation(ctx context.Context, uid, cid string) (err error)
 	SearchConversations(ctx context.Context, uid, key string, page *basic.Page) (cs []*Conversation, hasMore bool, err error)
+	UpdateConversationPin(ctx context.Context, uid, cid string, pinned bool) (err error)
+	UpdateConversationFolder(ctx context.Context, uid, cid string, fid primitive.ObjectID) (err error)
+	UpdateConversationTags(ctx context.Context, uid, cid string, tags []string) (err error)
+	ClearFolder(ctx context.Context, uid string, fid primitive.ObjectID) (err error)
+	ListTags(ctx context.Context, uid string) (tags []string, err error)
+	ListTrash(ctx context.Context, uid string, page *basic.Page) (cs []*Conversation, hasMore bool, err error)
+	RestoreConversation(ctx context.Context, uid, cid string) (err error)
+	ListExpired(ctx context.Context, before time.Time, limit int64) (cs []*Conversation, err error)
+	PurgeConversation(ctx context.Context, cid primitive.ObjectID) (err error)
 }
 
 type mongoMapper struct {
@@ -60,7 +81,7 @@ func (m *mongoMapper) CreateNewConversation(ctx context.Context, uid, botId stri
 		BotId:          botId,
 		CreateTime:     now,
 		UpdateTime:     now,
-		Brief:          "未命名对话",
+		Brief:          DefaultBrief,
 	}
 
 	// 插入
@@ -68,25 +89,55 @@ func (m *mongoMapper) CreateNewConversation(ctx context.Context, uid, botId stri
 	return c, err
 }
 
+// InsertConversation 插入一个已构建好的对话, 用于分叉等场景
+func (m *mongoMapper) InsertConversation(ctx context.Context, c *Conversation) (err error) {
+	_, err = m.conn.InsertOne(ctx, cacheKeyPrefix+c.ConversationId.Hex(), c)
+	return err
+}
+
 // ListConversations 分页查询用户对话列表
-func (m *mongoMapper) ListConversations(ctx context.Context, uid string, page *basic.Page) (cs []*Conversation, hasMore bool, err error) {
+func (m *mongoMapper) ListConversations(ctx context.Context, uid string, opt *ListOption, page *basic.Page) (cs []*Conversation, hasMore bool, err error) {
 	// 转换为ObjectID
 	oid, err := primitive.ObjectIDFromHex(uid)
 	if err != nil {
 		logs.Errorf("[mapper] [conversation] [ListConversation] from hex err:%s", errorx.ErrorWithoutStack(err))
 		return nil, false, err
 	}
+	if opt == nil {
+		opt = &ListOption{}
+	}
 
-	// 创建ID倒序
-	opts := options.Find().SetSort(bson.M{cst.Id: -1}).SetLimit(page.GetSize() + 1)
 	filter := bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
-	if page != nil && page.Cursor != nil { // 存在cursor时, 查询创建时间小于Cursor的
-		cursor, err := primitive.ObjectIDFromHex(*page.Cursor)
+	if opt.FolderId != nil {
+		if opt.FolderId.IsZero() {
+			filter[cst.FolderId] = bson.M{cst.Exists: false}
+		} else {
+			filter[cst.FolderId] = *opt.FolderId
+		}
+	}
+	if opt.Tag != "" {
+		filter[cst.Tags] = opt.Tag
+	}
+	if opt.Pinned != nil {
+		filter[cst.Pinned] = pinnedFilter(*opt.Pinned)
+	}
+	// 排序字段依次为置顶、更新时间与id, 默认按id即创建时间倒序
+	sort := bson.D{}
+	if opt.PinnedFirst {
+		sort = append(sort, bson.E{Key: cst.Pinned, Value: -1})
+	}
+	if opt.SortBy == cst.UpdateTime {
+		sort = append(sort, bson.E{Key: cst.UpdateTime, Value: -1})
+	}
+	sort = append(sort, bson.E{Key: cst.Id, Value: -1})
+	if page != nil && page.Cursor != nil { // 存在cursor时, 查询排在Cursor之后的
+		cursor, err := decodeCursor(*page.Cursor)
 		if err != nil {
 			return nil, false, err
 		}
-		filter[cst.Id] = bson.M{cst.LT: cursor}
+		filter = bson.M{cst.And: bson.A{filter, after(sort, cursor)}}
 	}
+	opts := options.Find().SetSort(sort).SetLimit(page.GetSize() + 1)
 	if err = m.conn.Find(ctx, &cs, filter, opts); err != nil {
 		return nil, false, err
 	}
@@ -94,6 +145,84 @@ func (m *mongoMapper) ListConversations(ctx context.Context, uid string, page *b
 	return cs, hasMore, err
 }
 
+// pinnedFilter 置顶状态的过滤条件, 未置顶的对话没有pinned字段
+func pinnedFilter(pinned bool) any {
+	if pinned {
+		return true
+	}
+	return bson.M{cst.Exists: false}
+}
+
+// EncodeCursor 将对话的排序字段编码为列表游标, 格式为 id.更新时间毫秒.是否置顶
+// 翻页时直接按游标中的字段过滤, 不需要重新查询游标对话, 对话被删除后游标仍然有效
+func EncodeCursor(c *Conversation) string {
+	pinned := 0
+	if c.Pinned {
+		pinned = 1
+	}
+	return fmt.Sprintf("%s.%d.%d", c.ConversationId.Hex(), c.UpdateTime.UnixMilli(), pinned)
+}
+
+// decodeCursor 解析游标中的排序字段
+func decodeCursor(cursor string) (*Conversation, error) {
+	parts := strings.Split(cursor, ".")
+	if len(parts) != 3 {
+		return nil, errInvalidCursor
+	}
+	oid, err := primitive.ObjectIDFromHex(parts[0])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	ms, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	return &Conversation{ConversationId: oid, UpdateTime: time.UnixMilli(ms), Pinned: parts[2] == "1"}, nil
+}
+
+// after 排在游标对话之后的过滤条件, sort中的字段均为倒序
+// 对于字段依次为k1, k2, ..., 条件为 k1 < c1 或 (k1 = c1 且 k2 < c2) 或 ...
+func after(sort bson.D, c *Conversation) bson.M {
+	var (
+		or    bson.A
+		equal = bson.M{}
+	)
+	for _, e := range sort {
+		cond := bson.M{}
+		for k, v := range equal {
+			cond[k] = v
+		}
+		switch e.Key {
+		case cst.Pinned:
+			if !c.Pinned { // 未置顶的对话之后没有更小的置顶状态
+				equal[e.Key] = pinnedFilter(false)
+				continue
+			}
+			cond[e.Key], equal[e.Key] = pinnedFilter(false), true
+		case cst.UpdateTime:
+			cond[e.Key], equal[e.Key] = bson.M{cst.LT: c.UpdateTime}, c.UpdateTime
+		case cst.Id:
+			cond[e.Key], equal[e.Key] = bson.M{cst.LT: c.ConversationId}, c.ConversationId
+		}
+		or = append(or, cond)
+	}
+	return bson.M{cst.Or: or}
+}
+
+// ListAllConversations 查询用户的全部对话, 按创建时间倒序
+func (m *mongoMapper) ListAllConversations(ctx context.Context, uid string) (cs []*Conversation, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [ListAllConversations] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, err
+	}
+	filter := bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	if err = m.conn.Find(ctx, &cs, filter, options.Find().SetSort(bson.M{cst.Id: -1})); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
 func (m *mongoMapper) DeleteConversation(ctx context.Context, uid, cid string) (err error) {
 	ouid, err := primitive.ObjectIDFromHex(uid)
 	if err != nil {
@@ -106,14 +235,17 @@ func (m *mongoMapper) DeleteConversation(ctx context.Context, uid, cid string) (
 		return err
 	}
 
-	// 更新对应uid,cid且未删除的对话
+	// 更新对应uid,cid且未删除的对话, 不存在或已删除时返回ErrNotFound
 	filter := bson.M{cst.Id: ocid, cst.UserId: ouid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
-	_, err = m.conn.UpdateOne(ctx, cacheKeyPrefix+cid, filter,
+	res, err := m.conn.UpdateOne(ctx, cacheKeyPrefix+cid, filter,
 		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.DeleteTime: time.Now(), cst.Status: cst.DeletedStatus}})
+	if err == nil && res.MatchedCount == 0 {
+		return monc.ErrNotFound
+	}
 	return err
 }
 
-// UpdateConversationBrief 更新对话简要概述
+// UpdateConversationBrief 用户重命名对话, 此后不再自动生成标题
 func (m *mongoMapper) UpdateConversationBrief(ctx context.Context, uid, cid, brief string) (err error) {
 	oids, err := util.ObjectIDsFromHex(uid, cid)
 	if err != nil {
@@ -123,7 +255,177 @@ func (m *mongoMapper) UpdateConversationBrief(ctx context.Context, uid, cid, bri
 	ouid, ocid := oids[0], oids[1]
 	filter := bson.M{cst.Id: ocid, cst.UserId: ouid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
 	_, err = m.conn.UpdateOne(ctx, cacheKeyPrefix+cid, filter,
-		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.Brief: brief}})
+		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.Brief: brief, cst.Renamed: true}})
+	return err
+}
+
+// UpdateConversationTitle 更新自动生成的标题, brief为空时只记录检查标题时的用户消息
+// 用户重命名过的对话不会更新, 此时ok为false
+func (m *mongoMapper) UpdateConversationTitle(ctx context.Context, cid, brief string, mid primitive.ObjectID) (ok bool, err error) {
+	oid, err := primitive.ObjectIDFromHex(cid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [UpdateConversationTitle] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return false, err
+	}
+	set := bson.M{}
+	if !mid.IsZero() {
+		set[cst.TitleMessageId] = mid
+	}
+	if brief != "" {
+		set[cst.Brief], set[cst.UpdateTime] = brief, time.Now()
+	}
+	if len(set) == 0 {
+		return false, nil
+	}
+	filter := bson.M{cst.Id: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}, cst.Renamed: bson.M{cst.NE: true}}
+	res, err := m.conn.UpdateOne(ctx, cacheKeyPrefix+cid, filter, bson.M{cst.Set: set})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+// UpdateConversationPin 置顶或取消置顶对话, 不改变对话的更新时间
+func (m *mongoMapper) UpdateConversationPin(ctx context.Context, uid, cid string, pinned bool) (err error) {
+	update := bson.M{cst.Set: bson.M{cst.Pinned: true}}
+	if !pinned {
+		update = bson.M{cst.Unset: bson.M{cst.Pinned: ""}}
+	}
+	return m.updateOwned(ctx, "UpdateConversationPin", uid, cid, update)
+}
+
+// UpdateConversationFolder 将对话移入文件夹, fid为零值时移出文件夹
+func (m *mongoMapper) UpdateConversationFolder(ctx context.Context, uid, cid string, fid primitive.ObjectID) (err error) {
+	update := bson.M{cst.Set: bson.M{cst.FolderId: fid}}
+	if fid.IsZero() {
+		update = bson.M{cst.Unset: bson.M{cst.FolderId: ""}}
+	}
+	return m.updateOwned(ctx, "UpdateConversationFolder", uid, cid, update)
+}
+
+// UpdateConversationTags 覆盖对话的标签
+func (m *mongoMapper) UpdateConversationTags(ctx context.Context, uid, cid string, tags []string) (err error) {
+	update := bson.M{cst.Set: bson.M{cst.Tags: tags}}
+	if len(tags) == 0 {
+		update = bson.M{cst.Unset: bson.M{cst.Tags: ""}}
+	}
+	return m.updateOwned(ctx, "UpdateConversationTags", uid, cid, update)
+}
+
+// updateOwned 更新用户未删除的对话
+func (m *mongoMapper) updateOwned(ctx context.Context, method, uid, cid string, update bson.M) (err error) {
+	oids, err := util.ObjectIDsFromHex(uid, cid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [%s] from hex err:%s", method, errorx.ErrorWithoutStack(err))
+		return err
+	}
+	filter := bson.M{cst.Id: oids[1], cst.UserId: oids[0], cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	res, err := m.conn.UpdateOne(ctx, cacheKeyPrefix+cid, filter, update)
+	if err == nil && res.MatchedCount == 0 {
+		return monc.ErrNotFound
+	}
+	return err
+}
+
+// ClearFolder 将文件夹中的对话全部移出, 用于删除文件夹
+func (m *mongoMapper) ClearFolder(ctx context.Context, uid string, fid primitive.ObjectID) (err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [ClearFolder] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	filter := bson.M{cst.UserId: oid, cst.FolderId: fid}
+	var cs []*Conversation
+	if err = m.conn.Find(ctx, &cs, filter, options.Find().SetProjection(bson.M{cst.Id: 1})); err != nil {
+		return err
+	}
+	if _, err = m.conn.UpdateManyNoCache(ctx, filter, bson.M{cst.Unset: bson.M{cst.FolderId: ""}}); err != nil {
+		return err
+	}
+	// 清除被修改对话的缓存
+	keys := make([]string, len(cs))
+	for i, c := range cs {
+		keys[i] = cacheKeyPrefix + c.ConversationId.Hex()
+	}
+	if len(keys) > 0 {
+		err = m.conn.DelCache(ctx, keys...)
+	}
+	return err
+}
+
+// ListTags 用户对话中使用过的全部标签
+func (m *mongoMapper) ListTags(ctx context.Context, uid string) (tags []string, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [ListTags] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, err
+	}
+	res, err := m.conn.Distinct(ctx, cst.Tags, bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}})
+	if err != nil {
+		return nil, err
+	}
+	err = res.Decode(&tags)
+	return tags, err
+}
+
+// ListTrash 分页查询回收站中的对话, 按删除时间倒序
+func (m *mongoMapper) ListTrash(ctx context.Context, uid string, page *basic.Page) (cs []*Conversation, hasMore bool, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [ListTrash] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, false, err
+	}
+
+	sort := bson.D{{Key: cst.DeleteTime, Value: -1}, {Key: cst.Id, Value: -1}}
+	filter := bson.M{cst.UserId: oid, cst.Status: cst.DeletedStatus}
+	if page != nil && page.Cursor != nil { // 存在cursor时, 查询删除时间早于Cursor的
+		cursor := &Conversation{}
+		oc, err := primitive.ObjectIDFromHex(*page.Cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		if err = m.conn.FindOneNoCache(ctx, cursor, bson.M{cst.Id: oc}); err != nil {
+			return nil, false, err
+		}
+		filter[cst.Or] = bson.A{
+			bson.M{cst.DeleteTime: bson.M{cst.LT: cursor.DeleteTime}},
+			bson.M{cst.DeleteTime: cursor.DeleteTime, cst.Id: bson.M{cst.LT: cursor.ConversationId}},
+		}
+	}
+	opts := options.Find().SetSort(sort).SetLimit(page.GetSize() + 1)
+	if err = m.conn.Find(ctx, &cs, filter, opts); err != nil {
+		return nil, false, err
+	}
+	cs, hasMore = util.SplitAndHasMore(cs, page)
+	return cs, hasMore, err
+}
+
+// RestoreConversation 从回收站恢复用户的对话
+func (m *mongoMapper) RestoreConversation(ctx context.Context, uid, cid string) (err error) {
+	oids, err := util.ObjectIDsFromHex(uid, cid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [RestoreConversation] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	filter := bson.M{cst.Id: oids[1], cst.UserId: oids[0], cst.Status: cst.DeletedStatus}
+	res, err := m.conn.UpdateOne(ctx, cacheKeyPrefix+cid, filter,
+		bson.M{cst.Set: bson.M{cst.Status: 0, cst.UpdateTime: time.Now()}, cst.Unset: bson.M{cst.DeleteTime: ""}})
+	if err == nil && res.MatchedCount == 0 {
+		return monc.ErrNotFound
+	}
+	return err
+}
+
+// ListExpired 查询删除时间早于before的对话, 用于彻底删除
+func (m *mongoMapper) ListExpired(ctx context.Context, before time.Time, limit int64) (cs []*Conversation, err error) {
+	opts := options.Find().SetSort(bson.M{cst.DeleteTime: 1}).SetLimit(limit)
+	err = m.conn.Find(ctx, &cs, bson.M{cst.Status: cst.DeletedStatus, cst.DeleteTime: bson.M{cst.LT: before}}, opts)
+	return cs, err
+}
+
+// PurgeConversation 彻底删除对话
+func (m *mongoMapper) PurgeConversation(ctx context.Context, cid primitive.ObjectID) (err error) {
+	_, err = m.conn.DeleteOne(ctx, cacheKeyPrefix+cid.Hex(), bson.M{cst.Id: cid})
 	return err
 }
 
@@ -173,3 +475,39 @@ func (m *mongoMapper) UpdateConversationExt(ctx context.Context, cid string, ext
 		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.Ext: ext}})
 	return err
 }
+
+// UpdateConversationSummary 更新对话摘要, summary为nil时清除摘要
+func (m *mongoMapper) UpdateConversationSummary(ctx context.Context, cid string, summary *Summary) error {
+	oid, err := primitive.ObjectIDFromHex(cid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [UpdateConversationSummary] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	update := bson.M{cst.Set: bson.M{cst.Summary: summary}}
+	if summary == nil {
+		update = bson.M{cst.Unset: bson.M{cst.Summary: ""}}
+	}
+	_, err = m.conn.UpdateOne(ctx, cacheKeyPrefix+cid, bson.M{cst.Id: oid}, update)
+	return err
+}
+
+// NewSection 新建段落, start为段落第一条消息的索引
+func (m *mongoMapper) NewSection(ctx context.Context, uid, cid string, start int32) (s *Section, err error) {
+	oids, err := util.ObjectIDsFromHex(uid, cid)
+	if err != nil {
+		logs.Errorf("[mapper] [conversation] [NewSection] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, err
+	}
+	now := time.Now()
+	s = &Section{SectionId: primitive.NewObjectID(), StartIndex: start, CreateTime: now}
+	filter := bson.M{cst.Id: oids[1], cst.UserId: oids[0], cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	res, err := m.conn.UpdateOne(ctx, cacheKeyPrefix+cid, filter,
+		bson.M{cst.Set: bson.M{cst.UpdateTime: now}, cst.Push: bson.M{cst.Sections: s}})
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	return s, nil
+}
@@ -0,0 +1,128 @@
+package conversation
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// matches 在内存中按after生成的过滤条件匹配对话, 只支持after用到的操作符
+func matches(c *Conversation, filter bson.M) bool {
+	for k, v := range filter {
+		if k == cst.Or {
+			matched := false
+			for _, cond := range v.(bson.A) {
+				matched = matched || matches(c, cond.(bson.M))
+			}
+			if !matched {
+				return false
+			}
+			continue
+		}
+		if !matchField(c, k, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchField(c *Conversation, k string, v any) bool {
+	if op, ok := v.(bson.M); ok {
+		if exists, ok := op[cst.Exists]; ok {
+			return (k == cst.Pinned && c.Pinned) == exists.(bool)
+		}
+		switch lt := op[cst.LT].(type) {
+		case time.Time:
+			return c.UpdateTime.Before(lt)
+		case primitive.ObjectID:
+			return c.ConversationId.Hex() < lt.Hex()
+		}
+		return false
+	}
+	switch k {
+	case cst.Pinned:
+		return c.Pinned == v.(bool)
+	case cst.UpdateTime:
+		return c.UpdateTime.Equal(v.(time.Time))
+	case cst.Id:
+		return c.ConversationId == v.(primitive.ObjectID)
+	}
+	return false
+}
+
+// less 按sort中的字段倒序比较, a排在b之前时返回true
+func less(s bson.D, a, b *Conversation) bool {
+	for _, e := range s {
+		switch e.Key {
+		case cst.Pinned:
+			if a.Pinned != b.Pinned {
+				return a.Pinned
+			}
+		case cst.UpdateTime:
+			if !a.UpdateTime.Equal(b.UpdateTime) {
+				return a.UpdateTime.After(b.UpdateTime)
+			}
+		case cst.Id:
+			return a.ConversationId.Hex() > b.ConversationId.Hex()
+		}
+	}
+	return false
+}
+
+func TestAfter(t *testing.T) {
+	base := time.Now().Truncate(time.Millisecond) // 数据库中的时间精确到毫秒
+	var cs []*Conversation
+	for i := 0; i < 12; i++ { // 置顶与更新时间交错, 且存在相同的更新时间
+		cs = append(cs, &Conversation{
+			ConversationId: primitive.NewObjectIDFromTimestamp(base.Add(time.Duration(i) * time.Second)),
+			Pinned:         i%3 == 0,
+			UpdateTime:     base.Add(time.Duration(i%4) * time.Minute),
+		})
+	}
+	pinned, update, id := bson.E{Key: cst.Pinned, Value: -1}, bson.E{Key: cst.UpdateTime, Value: -1}, bson.E{Key: cst.Id, Value: -1}
+	cases := []struct {
+		name string
+		sort bson.D
+	}{
+		{"id", bson.D{id}},
+		{"update time", bson.D{update, id}},
+		{"pinned first", bson.D{pinned, id}},
+		{"pinned first by update time", bson.D{pinned, update, id}},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		sorted := append([]*Conversation(nil), cs...)
+		sort.Slice(sorted, func(i, j int) bool { return less(c.sort, sorted[i], sorted[j]) })
+		for i, conv := range sorted {
+			cursor, err := decodeCursor(EncodeCursor(conv)) // 翻页时只使用游标中的排序字段
+			g.Expect(err).NotTo(HaveOccurred())
+			got := []*Conversation{}
+			for _, conv := range sorted {
+				if matches(conv, after(c.sort, cursor)) {
+					got = append(got, conv)
+				}
+			}
+			g.Expect(got).To(Equal(sorted[i+1:]), "%s cursor %d", c.name, i)
+		}
+	}
+}
+
+func TestCursor(t *testing.T) {
+	g := NewGomegaWithT(t)
+	c := &Conversation{ConversationId: primitive.NewObjectID(), UpdateTime: time.UnixMilli(1700000000123), Pinned: true}
+	got, err := decodeCursor(EncodeCursor(c))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.ConversationId).To(Equal(c.ConversationId))
+	g.Expect(got.UpdateTime.Equal(c.UpdateTime)).To(BeTrue())
+	g.Expect(got.Pinned).To(BeTrue())
+
+	for _, invalid := range []string{"", c.ConversationId.Hex(), "bad.1.0", c.ConversationId.Hex() + ".x.0"} {
+		_, err = decodeCursor(invalid)
+		g.Expect(err).To(MatchError(errInvalidCursor), invalid)
+	}
+}
@@ -25,6 +25,7 @@ type MongoMapper interface {
 	UpdateFeedback(ctx context.Context, feedback *FeedBack) error
 	Insert(ctx context.Context, uid string, action, typ int32, content string) error
 	ListFeedback(ctx context.Context, p *basic.Page, mid, uid *string, action, typ *int32) (int64, []*FeedBack, error)
+	DeleteByMessages(ctx context.Context, mids []primitive.ObjectID) error
 }
 
 type mongoMapper struct {
@@ -87,3 +88,12 @@ func (m *mongoMapper) ListFeedback(ctx context.Context, p *basic.Page, message,
 	total, err = m.conn.CountDocuments(ctx, filter)
 	return total, fbs, err
 }
+
+// DeleteByMessages 彻底删除消息关联的反馈
+func (m *mongoMapper) DeleteByMessages(ctx context.Context, mids []primitive.ObjectID) (err error) {
+	if len(mids) == 0 {
+		return nil
+	}
+	_, err = m.conn.DeleteMany(ctx, bson.M{cst.Id: bson.M{cst.In: mids}})
+	return err
+}
@@ -0,0 +1,18 @@
+package folder
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Folder 用户创建的对话文件夹, 一个对话最多归属于一个文件夹
+type Folder struct {
+	FolderId   primitive.ObjectID `json:"folder_id" bson:"_id"`                               // 主键
+	UserId     primitive.ObjectID `json:"user_id" bson:"user_id"`                             // 索引
+	Name       string             `json:"name" bson:"name"`                                   // 文件夹名称
+	CreateTime time.Time          `json:"create_time" bson:"create_time"`                     // 创建时间
+	UpdateTime time.Time          `json:"update_time" bson:"update_time"`                     // 更新时间
+	DeleteTime time.Time          `json:"delete_time,omitempty" bson:"delete_time,omitempty"` // 删除时间
+	Status     int32              `json:"status" bson:"status"`                               // 状态
+}
@@ -0,0 +1,106 @@
+package folder
+
+import (
+	"context"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var Mapper MongoMapper = (*mongoMapper)(nil)
+
+const (
+	collection = "folder"
+)
+
+type MongoMapper interface {
+	Insert(ctx context.Context, f *Folder) error
+	GetFolder(ctx context.Context, uid, fid string) (f *Folder, err error)
+	ListFolders(ctx context.Context, uid string) (fs []*Folder, err error)
+	Rename(ctx context.Context, uid, fid, name string) error
+	Delete(ctx context.Context, uid, fid string) error
+}
+
+type mongoMapper struct {
+	conn *monc.Model
+}
+
+func NewFolderMongoMapper(config *conf.Config) MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, collection, config.CacheConf)
+	Mapper = &mongoMapper{conn: conn}
+	return Mapper
+}
+
+// Insert 新增一个文件夹
+func (m *mongoMapper) Insert(ctx context.Context, f *Folder) (err error) {
+	now := time.Now()
+	if f.FolderId.IsZero() {
+		f.FolderId = primitive.NewObjectID()
+	}
+	f.CreateTime, f.UpdateTime = now, now
+	_, err = m.conn.InsertOneNoCache(ctx, f)
+	return err
+}
+
+// GetFolder 获取用户未删除的文件夹
+func (m *mongoMapper) GetFolder(ctx context.Context, uid, fid string) (f *Folder, err error) {
+	oids, err := util.ObjectIDsFromHex(uid, fid)
+	if err != nil {
+		logs.Errorf("[mapper] [folder] [GetFolder] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, err
+	}
+	f = &Folder{}
+	err = m.conn.FindOneNoCache(ctx, f, bson.M{cst.Id: oids[1], cst.UserId: oids[0], cst.Status: bson.M{cst.NE: cst.DeletedStatus}})
+	return f, err
+}
+
+// ListFolders 查询用户的全部文件夹, 按创建时间正序
+func (m *mongoMapper) ListFolders(ctx context.Context, uid string) (fs []*Folder, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [folder] [ListFolders] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, err
+	}
+	opts := options.Find().SetSort(bson.M{cst.Id: 1})
+	err = m.conn.Find(ctx, &fs, bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}, opts)
+	return fs, err
+}
+
+// Rename 重命名文件夹
+func (m *mongoMapper) Rename(ctx context.Context, uid, fid, name string) (err error) {
+	oids, err := util.ObjectIDsFromHex(uid, fid)
+	if err != nil {
+		logs.Errorf("[mapper] [folder] [Rename] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	filter := bson.M{cst.Id: oids[1], cst.UserId: oids[0], cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	res, err := m.conn.UpdateOneNoCache(ctx, filter, bson.M{cst.Set: bson.M{cst.Name: name, cst.UpdateTime: time.Now()}})
+	if err == nil && res.MatchedCount == 0 {
+		return monc.ErrNotFound
+	}
+	return err
+}
+
+// Delete 删除文件夹, 文件夹中的对话需要由调用方移出
+func (m *mongoMapper) Delete(ctx context.Context, uid, fid string) (err error) {
+	oids, err := util.ObjectIDsFromHex(uid, fid)
+	if err != nil {
+		logs.Errorf("[mapper] [folder] [Delete] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	filter := bson.M{cst.Id: oids[1], cst.UserId: oids[0], cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	res, err := m.conn.UpdateOneNoCache(ctx, filter,
+		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.DeleteTime: time.Now(), cst.Status: cst.DeletedStatus}})
+	if err == nil && res.MatchedCount == 0 {
+		return monc.ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/basic"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var Mapper MongoMapper = (*mongoMapper)(nil)
+
+const (
+	collection = "memory"
+)
+
+type MongoMapper interface {
+	Insert(ctx context.Context, m *Memory) error
+	UpdateContent(ctx context.Context, mid, cid primitive.ObjectID, content string) error
+	ListMemories(ctx context.Context, uid string, page *basic.Page) (ms []*Memory, hasMore bool, err error)
+	ListAllMemories(ctx context.Context, uid string, limit int64) (ms []*Memory, err error)
+	DeleteMemory(ctx context.Context, uid string, mids []string) (err error)
+	ClearMemories(ctx context.Context, uid string) (err error)
+}
+
+type mongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMemoryMongoMapper(config *conf.Config) MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, collection, config.CacheConf)
+	Mapper = &mongoMapper{conn: conn}
+	return Mapper
+}
+
+// Insert 新增一条记忆
+func (m *mongoMapper) Insert(ctx context.Context, mem *Memory) (err error) {
+	now := time.Now()
+	if mem.MemoryId.IsZero() {
+		mem.MemoryId = primitive.NewObjectID()
+	}
+	mem.CreateTime, mem.UpdateTime = now, now
+	_, err = m.conn.InsertOneNoCache(ctx, mem)
+	return err
+}
+
+// UpdateContent 更新记忆内容, 并记录最近一次来源的对话
+func (m *mongoMapper) UpdateContent(ctx context.Context, mid, cid primitive.ObjectID, content string) (err error) {
+	filter := bson.M{cst.Id: mid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	_, err = m.conn.UpdateOneNoCache(ctx, filter,
+		bson.M{cst.Set: bson.M{cst.Content: content, cst.ConversationId: cid, cst.UpdateTime: time.Now()}})
+	return err
+}
+
+// ListMemories 分页查询用户记忆, 按创建时间倒序
+func (m *mongoMapper) ListMemories(ctx context.Context, uid string, page *basic.Page) (ms []*Memory, hasMore bool, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [memory] [ListMemories] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, false, err
+	}
+
+	opts := options.Find().SetSort(bson.M{cst.Id: -1}).SetLimit(page.GetSize() + 1)
+	filter := bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	if page != nil && page.Cursor != nil { // 存在cursor时, 查询创建时间小于Cursor的
+		cursor, err := primitive.ObjectIDFromHex(*page.Cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		filter[cst.Id] = bson.M{cst.LT: cursor}
+	}
+	if err = m.conn.Find(ctx, &ms, filter, opts); err != nil {
+		return nil, false, err
+	}
+	ms, hasMore = util.SplitAndHasMore(ms, page)
+	return ms, hasMore, err
+}
+
+// ListAllMemories 查询用户最近更新的记忆, 用于提取时去重与对话时检索
+func (m *mongoMapper) ListAllMemories(ctx context.Context, uid string, limit int64) (ms []*Memory, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [memory] [ListAllMemories] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, err
+	}
+	opts := options.Find().SetSort(bson.M{cst.UpdateTime: -1}).SetLimit(limit)
+	err = m.conn.Find(ctx, &ms, bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}, opts)
+	return ms, err
+}
+
+// DeleteMemory 删除用户的指定记忆
+func (m *mongoMapper) DeleteMemory(ctx context.Context, uid string, mids []string) (err error) {
+	oids, err := util.ObjectIDsFromHex(append([]string{uid}, mids...)...)
+	if err != nil {
+		logs.Errorf("[mapper] [memory] [DeleteMemory] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	filter := bson.M{cst.Id: bson.M{cst.In: oids[1:]}, cst.UserId: oids[0], cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	_, err = m.conn.UpdateManyNoCache(ctx, filter,
+		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.DeleteTime: time.Now(), cst.Status: cst.DeletedStatus}})
+	return err
+}
+
+// ClearMemories 删除用户的全部记忆
+func (m *mongoMapper) ClearMemories(ctx context.Context, uid string) (err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [memory] [ClearMemories] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	filter := bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	_, err = m.conn.UpdateManyNoCache(ctx, filter,
+		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.DeleteTime: time.Now(), cst.Status: cst.DeletedStatus}})
+	return err
+}
@@ -0,0 +1,19 @@
+package memory
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Memory 用户记忆, 从对话中提取的关于用户的长期信息, 跨对话使用
+type Memory struct {
+	MemoryId       primitive.ObjectID `json:"memory_id" bson:"_id"`                               // 主键
+	UserId         primitive.ObjectID `json:"user_id" bson:"user_id"`                             // 索引
+	Content        string             `json:"content" bson:"content"`                             // 记忆内容
+	ConversationId primitive.ObjectID `json:"conversation_id" bson:"conversation_id"`             // 最近一次来源的对话id
+	CreateTime     time.Time          `json:"create_time" bson:"create_time"`                     // 创建时间
+	UpdateTime     time.Time          `json:"update_time" bson:"update_time"`                     // 更新时间
+	DeleteTime     time.Time          `json:"delete_time,omitempty" bson:"delete_time,omitempty"` // 删除时间
+	Status         int32              `json:"status" bson:"status"`                               // 状态
+}
@@ -3,6 +3,7 @@ package message
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/xh-polaris/innospark-core-api/biz/application/dto/basic"
 	"github.com/xh-polaris/innospark-core-api/biz/conf"
@@ -22,14 +23,30 @@ var _ MongoMapper = (*mongoMapper)(nil)
 const (
 	collection     = "message"
 	cacheKeyPrefix = "cache:message:"
+
+	conversationCollection = "conversation" // 对话集合, 检索时用于过滤已删除的对话
 )
 
 type MongoMapper interface {
 	UpdateMany(ctx context.Context, msg []*Message) (err error)
 	ListMessage(ctx context.Context, conversation string, page *basic.Page) (msgs []*Message, hasMore bool, err error)
 	Feedback(ctx context.Context, mid primitive.ObjectID, feedback int32) (_ *Message, err error)
-	RetrieveMessages(ctx context.Context, conversation string, size int) (msgs []*Message, err error)
+	RetrieveMessages(ctx context.Context, conversation, section string, size int) (msgs []*Message, err error)
 	InsertOne(ctx context.Context, msg *Message) error
+	InsertMany(ctx context.Context, msgs []*Message) error
+	FindOne(ctx context.Context, mid string) (*Message, error)
+	FindMany(ctx context.Context, mids []primitive.ObjectID) (msgs []*Message, err error)
+	RetrieveBranches(ctx context.Context, cid primitive.ObjectID) (msgs []*Message, err error)
+	Fork(ctx context.Context, update, insert []*Message) (err error)
+	SearchMessages(ctx context.Context, uid string, terms []string, think bool, page *basic.Page) (msgs []*Message, hasMore bool, err error)
+	TrashByConversation(ctx context.Context, cid string) (err error)
+	RestoreByConversation(ctx context.Context, cid string) (err error)
+	FindAllByConversation(ctx context.Context, cid primitive.ObjectID) (msgs []*Message, err error)
+	PurgeByConversation(ctx context.Context, cid primitive.ObjectID) (err error)
+	ReferencedURLs(ctx context.Context, cid primitive.ObjectID, urls []string) (refs []string, err error)
+	ActiveUsers(ctx context.Context, start, end time.Time, period, tz string) (counts []*util.DateCount, err error)
+	Retention(ctx context.Context, start, end, activeEnd time.Time, tz string) (rs []*Retention, err error)
+	Statistics(ctx context.Context, start, end time.Time, groupBy, tz string) (stats []*Stat, err error)
 }
 
 type mongoMapper struct {
@@ -41,8 +58,8 @@ func NewMessageMongoMapper(config *conf.Config) MongoMapper {
 	return &mongoMapper{conn: conn}
 }
 
-// RetrieveMessages 取出按时间顺序取出size条msg记录, 为0则取出所有的
-func (m *mongoMapper) RetrieveMessages(ctx context.Context, conversation string, size int) (msgs []*Message, err error) {
+// RetrieveMessages 取出按时间顺序取出size条msg记录, 为0则取出所有的, section不为空时只取出该段落的消息
+func (m *mongoMapper) RetrieveMessages(ctx context.Context, conversation, section string, size int) (msgs []*Message, err error) {
 	oid, err := primitive.ObjectIDFromHex(conversation)
 	if err != nil {
 		return nil, err
@@ -52,20 +69,82 @@ func (m *mongoMapper) RetrieveMessages(ctx context.Context, conversation string,
 	if size > 0 {
 		opts.SetLimit(int64(size))
 	}
-	if err = m.conn.Find(ctx, &msgs, bson.M{cst.ConversationId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}},
-		opts); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+	filter := bson.M{cst.ConversationId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	if section != "" {
+		sid, err := primitive.ObjectIDFromHex(section)
+		if err != nil {
+			return nil, err
+		}
+		filter[cst.SectionId] = sid
+	}
+	if err = m.conn.Find(ctx, &msgs, filter, opts); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
 		logs.Errorf("[message mapper] find err:%s", errorx.ErrorWithoutStack(err))
 		return nil, err
 	}
 	return msgs, nil
 }
 
+// FindOne 根据id获取一条msg
+func (m *mongoMapper) FindOne(ctx context.Context, mid string) (*Message, error) {
+	oid, err := primitive.ObjectIDFromHex(mid)
+	if err != nil {
+		return nil, err
+	}
+	var msg Message
+	if err = m.conn.FindOneNoCache(ctx, &msg, bson.M{cst.Id: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// FindMany 根据id取出一批msg, 按索引倒序
+func (m *mongoMapper) FindMany(ctx context.Context, mids []primitive.ObjectID) (msgs []*Message, err error) {
+	if len(mids) == 0 {
+		return nil, nil
+	}
+	opts := options.Find().SetSort(bson.M{cst.Index: -1})
+	if err = m.conn.Find(ctx, &msgs, bson.M{cst.Id: bson.M{cst.In: mids}, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}, opts); err != nil {
+		logs.Errorf("[message mapper] find many err:%s", errorx.ErrorWithoutStack(err))
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// RetrieveBranches 取出对话中所有msg的分支结构, 只包含构建消息树所需的字段, 不包含消息内容
+// 用于还原激活的路径, 再通过FindMany只取出需要的完整消息
+func (m *mongoMapper) RetrieveBranches(ctx context.Context, cid primitive.ObjectID) (msgs []*Message, err error) {
+	opts := options.Find().SetProjection(bson.M{
+		cst.Id: 1, cst.SectionId: 1, cst.ParentId: 1, cst.ReplyId: 1, cst.Index: 1, cst.Inactive: 1, cst.Role: 1,
+	})
+	filter := bson.M{cst.ConversationId: cid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	if err = m.conn.Find(ctx, &msgs, filter, opts); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		logs.Errorf("[message mapper] retrieve branches err:%s", errorx.ErrorWithoutStack(err))
+		return nil, err
+	}
+	return msgs, nil
+}
+
 // InsertOne 插入一条msg
 func (m *mongoMapper) InsertOne(ctx context.Context, msg *Message) error {
+	msg.IndexTerms()
 	_, err := m.conn.InsertOneNoCache(ctx, msg)
 	return err
 }
 
+// InsertMany 批量插入msg
+func (m *mongoMapper) InsertMany(ctx context.Context, msgs []*Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	docs := make([]any, len(msgs))
+	for i, msg := range msgs {
+		msg.IndexTerms()
+		docs[i] = msg
+	}
+	_, err := m.conn.InsertMany(ctx, docs)
+	return err
+}
+
 // UpdateMany 批量更新信息, 一个事务
 func (m *mongoMapper) UpdateMany(ctx context.Context, msgs []*Message) (err error) {
 	if msgs == nil || len(msgs) == 0 {
@@ -81,6 +160,7 @@ func (m *mongoMapper) UpdateMany(ctx context.Context, msgs []*Message) (err erro
 	if _, err = session.WithTransaction(ctx, func(sessCtx context.Context) (any, error) {
 		var operations []mongo.WriteModel
 		for _, msg := range msgs { // 设置批量更新行为
+			msg.IndexTerms()
 			filter := bson.M{cst.Id: msg.MessageId}
 			update := bson.M{cst.Set: msg}
 			operations = append(operations, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update))
@@ -93,6 +173,145 @@ func (m *mongoMapper) UpdateMany(ctx context.Context, msgs []*Message) (err erro
 	return err
 }
 
+// Fork 分叉, 在一个事务中更新旧分支的消息并插入新消息
+func (m *mongoMapper) Fork(ctx context.Context, update, insert []*Message) (err error) {
+	session, err := m.conn.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	if _, err = session.WithTransaction(ctx, func(sessCtx context.Context) (any, error) {
+		var operations []mongo.WriteModel
+		for _, msg := range update {
+			msg.IndexTerms()
+			operations = append(operations, mongo.NewUpdateOneModel().SetFilter(bson.M{cst.Id: msg.MessageId}).SetUpdate(bson.M{cst.Set: msg}))
+		}
+		for _, msg := range insert {
+			msg.IndexTerms()
+			operations = append(operations, mongo.NewInsertOneModel().SetDocument(msg))
+		}
+		_, err := m.conn.BulkWrite(sessCtx, operations)
+		return nil, err
+	}); err != nil {
+		logs.Errorf("[message mapper] fork: bulk write err:%s", errorx.ErrorWithoutStack(err))
+	}
+	return err
+}
+
+// SearchMessages 在用户的全部消息中检索包含全部词项的用户与模型消息, think为true时也检索深度思考内容
+// 已删除的消息与已删除对话中的消息不会返回, 按id倒序分页
+func (m *mongoMapper) SearchMessages(ctx context.Context, uid string, terms []string, think bool, page *basic.Page) (msgs []*Message, hasMore bool, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[message mapper] [SearchMessages] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, false, err
+	}
+	match := bson.M{
+		cst.UserId: oid,
+		cst.Status: bson.M{cst.NE: cst.DeletedStatus},
+		cst.Role:   bson.M{cst.In: []int32{cst.UserEnum, cst.AssistantEnum}},
+		cst.Terms:  bson.M{cst.All: terms},
+	}
+	if think {
+		delete(match, cst.Terms)
+		match[cst.Or] = bson.A{bson.M{cst.Terms: bson.M{cst.All: terms}}, bson.M{cst.ThinkTerms: bson.M{cst.All: terms}}}
+	}
+	if page != nil && page.Cursor != nil { // 存在cursor时, 查询id小于Cursor的
+		cursor, err := primitive.ObjectIDFromHex(*page.Cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		match[cst.Id] = bson.M{cst.LT: cursor}
+	}
+	pipeline := bson.A{
+		bson.M{cst.Match: match},
+		bson.M{cst.Sort: bson.M{cst.Id: -1}},
+		bson.M{cst.Lookup: bson.M{"from": conversationCollection, "localField": cst.ConversationId, "foreignField": cst.Id, "as": "conversation"}},
+		bson.M{cst.Match: bson.M{"conversation.0": bson.M{cst.Exists: true}, "conversation." + cst.Status: bson.M{cst.NE: cst.DeletedStatus}}},
+		bson.M{cst.Limit: page.GetSize() + 1},
+		bson.M{cst.Project: bson.M{"conversation": 0, cst.Terms: 0, cst.ThinkTerms: 0}},
+	}
+	if err = m.conn.Aggregate(ctx, &msgs, pipeline); err != nil {
+		logs.Errorf("[message mapper] search err:%s", errorx.ErrorWithoutStack(err))
+		return nil, false, err
+	}
+	msgs, hasMore = util.SplitAndHasMore(msgs, page)
+	return msgs, hasMore, nil
+}
+
+// TrashByConversation 删除对话时将其中未删除的消息一并删除, 原状态备份在trash_status中以便恢复
+func (m *mongoMapper) TrashByConversation(ctx context.Context, cid string) (err error) {
+	oid, err := primitive.ObjectIDFromHex(cid)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{cst.ConversationId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	_, err = m.conn.UpdateManyNoCache(ctx, filter, bson.A{bson.M{cst.Set: bson.M{
+		cst.TrashStatus: "$" + cst.Status,
+		cst.Status:      cst.DeletedStatus,
+		cst.DeleteTime:  time.Now(),
+	}}})
+	return err
+}
+
+// RestoreByConversation 恢复对话时还原随对话一起删除的消息, 单独删除的消息不会恢复
+func (m *mongoMapper) RestoreByConversation(ctx context.Context, cid string) (err error) {
+	oid, err := primitive.ObjectIDFromHex(cid)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{cst.ConversationId: oid, cst.TrashStatus: bson.M{cst.Exists: true}}
+	_, err = m.conn.UpdateManyNoCache(ctx, filter, bson.A{
+		bson.M{cst.Set: bson.M{cst.Status: "$" + cst.TrashStatus}},
+		bson.M{cst.Unset: bson.A{cst.TrashStatus, cst.DeleteTime}},
+	})
+	return err
+}
+
+// FindAllByConversation 取出对话的全部消息, 包括已删除的消息, 用于彻底删除前收集关联数据
+func (m *mongoMapper) FindAllByConversation(ctx context.Context, cid primitive.ObjectID) (msgs []*Message, err error) {
+	err = m.conn.Find(ctx, &msgs, bson.M{cst.ConversationId: cid})
+	return msgs, err
+}
+
+// PurgeByConversation 彻底删除对话的全部消息
+func (m *mongoMapper) PurgeByConversation(ctx context.Context, cid primitive.ObjectID) (err error) {
+	_, err = m.conn.DeleteMany(ctx, bson.M{cst.ConversationId: cid})
+	return err
+}
+
+// ReferencedURLs 其他对话的消息中仍在引用的附件链接, 分叉与导入的对话会复用原消息的附件
+func (m *mongoMapper) ReferencedURLs(ctx context.Context, cid primitive.ObjectID, urls []string) (refs []string, err error) {
+	if len(urls) == 0 {
+		return nil, nil
+	}
+	in := bson.M{cst.In: urls}
+	filter := bson.M{cst.ConversationId: bson.M{cst.NE: cid}, cst.Or: bson.A{
+		bson.M{"ext.attach_info.access_url": in},
+		bson.M{"user_input_multi_content.image.messagepartcommon.url": in},
+		bson.M{"user_input_multi_content.audio.messagepartcommon.url": in},
+		bson.M{"user_input_multi_content.video.messagepartcommon.url": in},
+		bson.M{"user_input_multi_content.file.messagepartcommon.url": in},
+	}}
+	var msgs []*Message
+	opts := options.Find().SetProjection(bson.M{cst.Ext: 1, "user_input_multi_content": 1})
+	if err = m.conn.Find(ctx, &msgs, filter, opts); err != nil {
+		return nil, err
+	}
+	wanted, seen := map[string]bool{}, map[string]bool{}
+	for _, u := range urls {
+		wanted[u] = true
+	}
+	for _, msg := range msgs {
+		for _, u := range msg.AttachmentURLs() {
+			if wanted[u] && !seen[u] {
+				seen[u], refs = true, append(refs, u)
+			}
+		}
+	}
+	return refs, nil
+}
+
 // ListMessage 分页获取Message
 func (m *mongoMapper) ListMessage(ctx context.Context, conversation string, page *basic.Page) (msgs []*Message, hasMore bool, err error) {
 	ocid, err := primitive.ObjectIDFromHex(conversation)
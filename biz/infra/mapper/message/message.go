@@ -1,9 +1,11 @@
 package message
 
 import (
+	"strings"
 	"time"
 
 	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/pkg/segment"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -20,6 +22,9 @@ type Message struct {
 	UserId                   primitive.ObjectID   `json:"user_id" bson:"user_id"`                                                                // 用户id
 	Index                    int32                `json:"index" bson:"index"`                                                                    // 消息索引
 	ReplyId                  primitive.ObjectID   `json:"reply_id,omitempty" bson:"reply_id,omitempty"`                                          // 回复id, 只有模型消息有
+	ParentId                 primitive.ObjectID   `json:"parent_id,omitempty" bson:"parent_id,omitempty"`                                        // 父消息id, 段落的第一条消息为段落id, 旧版本消息没有
+	Branch                   int32                `json:"branch" bson:"branch"`                                                                  // 在兄弟消息中的分支序号
+	Inactive                 bool                 `json:"inactive,omitempty" bson:"inactive"`                                                    // 是否为未激活的分支, 旧版本消息默认激活
 	Content                  string               `json:"content" bson:"content"`                                                                // 消息内容, json字符串
 	ContentType              int32                `json:"content_type" bson:"content_type"`                                                      // 内容类型, text/think/suggest, 依次为0,1,2
 	MessageType              int32                `json:"message_type" bson:"message_type"`                                                      // 消息类型, 默认为text, 0
@@ -32,19 +37,82 @@ type Message struct {
 	UpdateTime               time.Time            `json:"update_time" bson:"update_time"`                                                        // 更新时间
 	DeleteTime               time.Time            `json:"delete_time,omitempty" bson:"delete_time,omitempty"`                                    // 删除时间
 	Status                   int32                `json:"status" bson:"status"`                                                                  // 状态, 默认/regen未选择/regen被选择/替换过/中断, 依次是0,1,2,3
+	Terms                    []string             `json:"-" bson:"terms,omitempty"`                                                              // 检索词项, 由消息文本与OCR结果分词得到
+	ThinkTerms               []string             `json:"-" bson:"think_terms,omitempty"`                                                        // 深度思考内容的检索词项
+}
+
+// SearchText 参与检索的消息文本, 包括多模态消息中的文本与OCR结果
+func (m *Message) SearchText() string {
+	texts := []string{m.Content}
+	for _, part := range m.UserInputMultiContent {
+		if part.Type == ChatMessagePartTypeText {
+			texts = append(texts, part.Text)
+		}
+	}
+	for _, part := range m.AssistantGenMultiContent {
+		if part.Type == ChatMessagePartTypeText {
+			texts = append(texts, part.Text)
+		}
+	}
+	if m.Ext != nil && m.Ext.Ocr != "" {
+		texts = append(texts, m.Ext.Ocr)
+	}
+	return strings.Join(texts, "\n")
+}
+
+// AttachmentURLs 消息中用户上传的附件链接, 包括附件信息与多模态内容中的链接
+func (m *Message) AttachmentURLs() (urls []string) {
+	if m.Ext != nil {
+		for _, a := range m.Ext.AttachInfo {
+			if a.AccessURL != "" {
+				urls = append(urls, a.AccessURL)
+			}
+		}
+	}
+	for _, part := range m.UserInputMultiContent {
+		var common *MessagePartCommon
+		switch {
+		case part.Image != nil:
+			common = &part.Image.MessagePartCommon
+		case part.Audio != nil:
+			common = &part.Audio.MessagePartCommon
+		case part.Video != nil:
+			common = &part.Video.MessagePartCommon
+		case part.File != nil:
+			common = &part.File.MessagePartCommon
+		}
+		if common != nil && common.URL != nil && !strings.HasPrefix(*common.URL, "data:") {
+			urls = append(urls, *common.URL)
+		}
+	}
+	return urls
+}
+
+// IndexTerms 写入前重新计算检索词项, 触发违禁词的消息不参与检索
+func (m *Message) IndexTerms() {
+	m.Terms, m.ThinkTerms = nil, nil
+	if m.Ext != nil && m.Ext.Sensitive {
+		return
+	}
+	m.Terms = segment.Terms(m.SearchText())
+	if m.Ext != nil && m.Ext.Think != "" {
+		m.ThinkTerms = segment.Terms(m.Ext.Think)
+	}
 }
 
 type Ext struct {
-	BotState   string        `json:"bot_state" bson:"bot_state"`                         // json字符串, 模型信息
-	Brief      string        `json:"brief,omitempty" bson:"brief,omitempty"`             // 内容备份
-	Think      string        `json:"think,omitempty" bson:"think,omitempty"`             // 深度思考内容
-	Suggest    string        `json:"suggest,omitempty" bson:"suggest,omitempty"`         // 建议内容
-	Cite       []*Cite       `json:"cite,omitempty" bson:"cite,omitempty"`               // 引用
-	Code       []*Code       `json:"code,omitempty" bson:"code,omitempty"`               // 代码
-	Sensitive  bool          `json:"sensitive,omitempty" bson:"sensitive,omitempty"`     // 是否触发违禁词
-	AttachInfo []*AttachInfo `json:"attach_info,omitempty" bson:"attach_info,omitempty"` // 附件信息
-	Usage      *Usage        `json:"usage,omitempty" bson:"usage,omitempty"`             // 用量信息
-	Ocr        string        `json:"ocr,omitempty" bson:"ocr,omitempty"`                 // ocr结果
+	BotState    string        `json:"bot_state" bson:"bot_state"`                           // json字符串, 模型信息
+	Brief       string        `json:"brief,omitempty" bson:"brief,omitempty"`               // 内容备份
+	Think       string        `json:"think,omitempty" bson:"think,omitempty"`               // 深度思考内容
+	Suggest     string        `json:"suggest,omitempty" bson:"suggest,omitempty"`           // 建议内容
+	Cite        []*Cite       `json:"cite,omitempty" bson:"cite,omitempty"`                 // 引用
+	Code        []*Code       `json:"code,omitempty" bson:"code,omitempty"`                 // 代码
+	Sensitive   bool          `json:"sensitive,omitempty" bson:"sensitive,omitempty"`       // 是否触发违禁词
+	AttachInfo  []*AttachInfo `json:"attach_info,omitempty" bson:"attach_info,omitempty"`   // 附件信息
+	Usage       *Usage        `json:"usage,omitempty" bson:"usage,omitempty"`               // 用量信息
+	Ocr         string        `json:"ocr,omitempty" bson:"ocr,omitempty"`                   // ocr结果
+	ToolCalls   []*ToolCall   `json:"tool_calls,omitempty" bson:"tool_calls,omitempty"`     // 工具调用
+	InvalidCite []int32       `json:"invalid_cite,omitempty" bson:"invalid_cite,omitempty"` // 回答中不存在的引用索引
 }
 
 type Cite struct {
@@ -55,6 +123,7 @@ type Cite struct {
 	SiteName      string `json:"siteName" bson:"site_name"`
 	SiteIcon      string `json:"siteIcon" bson:"site_icon"`
 	DatePublished string `json:"datePublished" bson:"date_published"`
+	Used          int32  `json:"used,omitempty" bson:"used,omitempty"` // 回答中的引用次数
 }
 
 type Usage struct {
@@ -66,6 +135,16 @@ type Usage struct {
 	CompletionTokens int `json:"completion_tokens,omitempty" bson:"completion_tokens,omitempty"`
 	// TotalTokens is the total number of tokens.
 	TotalTokens int `json:"total_tokens,omitempty" bson:"total_tokens,omitempty"`
+	// Context is the trimming result of the conversation history.
+	Context *ContextUsage `json:"context,omitempty" bson:"context,omitempty"`
+}
+
+type ContextUsage struct {
+	Limit      int `json:"limit,omitempty" bson:"limit,omitempty"`           // 模型上下文上限
+	Tokens     int `json:"tokens,omitempty" bson:"tokens,omitempty"`         // 裁剪后的上下文估算token数
+	Dropped    int `json:"dropped,omitempty" bson:"dropped,omitempty"`       // 丢弃的消息数
+	Compressed int `json:"compressed,omitempty" bson:"compressed,omitempty"` // 压缩的消息数
+	Summarized int `json:"summarized,omitempty" bson:"summarized,omitempty"` // 被摘要替代的消息数
 }
 
 type PromptTokenDetails struct {
@@ -79,6 +158,14 @@ type Code struct {
 	Code     string `json:"code" bson:"code"`
 }
 
+type ToolCall struct {
+	Id        string `json:"id" bson:"id"`               // 调用id
+	Round     int32  `json:"round" bson:"round"`         // 调用轮次
+	Name      string `json:"name" bson:"name"`           // 工具名称
+	Arguments string `json:"arguments" bson:"arguments"` // 调用参数
+	Result    string `json:"result" bson:"result"`       // 调用结果
+}
+
 type AttachInfo struct {
 	AccessURL string `json:"access_url" bson:"access_url"`
 	Key       string `json:"key" bson:"key"`
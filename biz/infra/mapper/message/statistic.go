@@ -0,0 +1,109 @@
+package message
+
+import (
+	"context"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const userCollection = "user" // 用户集合, 统计活跃与留存时使用
+
+// 消息量统计的分组维度
+const (
+	StatByDay   = "day"
+	StatByModel = "model"
+	StatByBot   = "bot"
+)
+
+// Retention 注册周期为Cohort的用户中, 在Week这一周有发送消息的人数
+type Retention struct {
+	Cohort string `bson:"cohort"`
+	Week   string `bson:"week"`
+	Count  int64  `bson:"count"`
+}
+
+// Stat 一个分组内模型回复的消息量与反馈
+type Stat struct {
+	Key      string `bson:"_id"`
+	Messages int64  `bson:"messages"`
+	Likes    int64  `bson:"likes"`
+	Dislikes int64  `bson:"dislikes"`
+}
+
+// ActiveUsers 按周期统计[start, end)内的活跃用户数, 发送过消息或登录过的用户视为活跃, 按日期正序
+// 用户只记录了最近一次登录时间, 更早的登录只能通过消息体现
+func (m *mongoMapper) ActiveUsers(ctx context.Context, start, end time.Time, period, tz string) (counts []*util.DateCount, err error) {
+	login := bson.A{
+		bson.M{cst.Match: bson.M{cst.LoginTime: bson.M{cst.GTE: start, cst.LT: end}}},
+		bson.M{cst.Project: bson.M{cst.UserId: "$" + cst.Id, cst.CreateTime: "$" + cst.LoginTime}},
+	}
+	pipeline := bson.A{
+		bson.M{cst.Match: bson.M{cst.Role: cst.UserEnum, cst.CreateTime: bson.M{cst.GTE: start, cst.LT: end}}},
+		bson.M{cst.Project: bson.M{cst.UserId: 1, cst.CreateTime: 1}},
+		bson.M{cst.UnionWith: bson.M{"coll": userCollection, "pipeline": login}},
+		bson.M{cst.Group: bson.M{cst.Id: bson.M{"date": util.PeriodOf("$"+cst.CreateTime, period, tz), "user": "$" + cst.UserId}}},
+		bson.M{cst.Group: bson.M{cst.Id: "$_id.date", "count": bson.M{cst.Sum: 1}}},
+		bson.M{cst.Sort: bson.M{cst.Id: 1}},
+	}
+	err = m.conn.Aggregate(ctx, &counts, pipeline)
+	return counts, err
+}
+
+// Retention 统计[start, end)内注册的用户按注册周分组后, 在[start, activeEnd)内每周发送过消息的人数
+func (m *mongoMapper) Retention(ctx context.Context, start, end, activeEnd time.Time, tz string) (rs []*Retention, err error) {
+	pipeline := bson.A{
+		bson.M{cst.Match: bson.M{cst.Role: cst.UserEnum, cst.CreateTime: bson.M{cst.GTE: start, cst.LT: activeEnd}}},
+		bson.M{cst.Group: bson.M{cst.Id: bson.M{"user": "$" + cst.UserId, "week": util.PeriodOf("$"+cst.CreateTime, util.PeriodWeek, tz)}}},
+		bson.M{cst.Lookup: bson.M{"from": userCollection, "localField": "_id.user", "foreignField": cst.Id, "as": "user"}},
+		bson.M{cst.Unwind: "$user"},
+		bson.M{cst.Match: bson.M{"user." + cst.CreateTime: bson.M{cst.GTE: start, cst.LT: end}}},
+		bson.M{cst.Group: bson.M{
+			cst.Id:  bson.M{"cohort": util.PeriodOf("$user."+cst.CreateTime, util.PeriodWeek, tz), "week": "$_id.week"},
+			"count": bson.M{cst.Sum: 1},
+		}},
+		bson.M{cst.Project: bson.M{cst.Id: 0, "cohort": "$_id.cohort", "week": "$_id.week", "count": 1}},
+	}
+	err = m.conn.Aggregate(ctx, &rs, pipeline)
+	return rs, err
+}
+
+// Statistics 按天、模型或智能体统计[start, end)内模型回复的消息量与点赞点踩数
+// 按天分组时按日期正序, 其余按消息量倒序
+func (m *mongoMapper) Statistics(ctx context.Context, start, end time.Time, groupBy, tz string) (stats []*Stat, err error) {
+	var key any
+	sort := bson.D{{Key: "messages", Value: -1}, {Key: cst.Id, Value: 1}}
+	switch groupBy {
+	case StatByModel:
+		key = botState(cst.Model)
+	case StatByBot:
+		key = botState(cst.BotId)
+	default:
+		key, sort = util.PeriodOf("$"+cst.CreateTime, util.PeriodDay, tz), bson.D{{Key: cst.Id, Value: 1}}
+	}
+	count := func(feedback int) bson.M {
+		return bson.M{cst.Sum: bson.M{cst.Cond: bson.A{bson.M{cst.Eq: bson.A{"$" + cst.Feedback, feedback}}, 1, 0}}}
+	}
+	pipeline := bson.A{
+		bson.M{cst.Match: bson.M{cst.Role: cst.AssistantEnum, cst.CreateTime: bson.M{cst.GTE: start, cst.LT: end}}},
+		bson.M{cst.Group: bson.M{
+			cst.Id:     key,
+			"messages": bson.M{cst.Sum: 1},
+			"likes":    count(cst.FeedbackLike),
+			"dislikes": count(cst.FeedbackDislike),
+		}},
+		bson.M{cst.Sort: sort},
+	}
+	err = m.conn.Aggregate(ctx, &stats, pipeline)
+	return stats, err
+}
+
+// botState 从模型消息的ext.bot_state中取出字段, bot_state为固定格式的json字符串, 不存在时为空字符串
+func botState(field string) bson.M {
+	return bson.M{"$let": bson.M{
+		"vars": bson.M{"m": bson.M{"$regexFind": bson.M{"input": "$ext.bot_state", "regex": `"` + field + `":"([^"]*)"`}}},
+		"in":   bson.M{"$ifNull": bson.A{bson.M{"$arrayElemAt": bson.A{"$$m.captures", 0}}, ""}},
+	}}
+}
@@ -0,0 +1,114 @@
+package message
+
+import (
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Tree 消息树, 同一父消息的子消息互为兄弟分支, 每组兄弟中只有一条处于激活状态
+// 段落的第一条消息以段落id为父消息; 旧版本消息没有父消息, 视为同一段落中上一条消息的子消息
+type Tree struct {
+	sections []primitive.ObjectID                      // 段落id, 按索引正序
+	messages map[primitive.ObjectID]*Message           // 全部消息
+	children map[primitive.ObjectID][]*Message         // 子消息, 按索引正序
+	parent   map[primitive.ObjectID]primitive.ObjectID // 实际的父消息
+	last     int32                                     // 最新消息的索引, 没有消息时为-1
+}
+
+// NewTree 根据一批消息构建消息树, msgs可以跨越多个段落
+func NewTree(msgs []*Message) *Tree {
+	t := &Tree{
+		messages: make(map[primitive.ObjectID]*Message, len(msgs)),
+		children: make(map[primitive.ObjectID][]*Message, len(msgs)),
+		parent:   make(map[primitive.ObjectID]primitive.ObjectID, len(msgs)),
+		last:     -1,
+	}
+	sorted := make([]*Message, len(msgs))
+	copy(sorted, msgs)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+	for _, msg := range sorted {
+		t.messages[msg.MessageId] = msg
+	}
+
+	prev := map[primitive.ObjectID]primitive.ObjectID{} // 段落中上一条消息
+	for _, msg := range sorted {
+		sid := msg.SectionId
+		if _, ok := prev[sid]; !ok {
+			t.sections, prev[sid] = append(t.sections, sid), sid
+		}
+		p := msg.ParentId
+		if _, ok := t.messages[p]; p.IsZero() || (p != sid && !ok) { // 旧版本消息或父消息已删除
+			p = prev[sid]
+		}
+		t.parent[msg.MessageId] = p
+		t.children[p] = append(t.children[p], msg)
+		prev[sid], t.last = msg.MessageId, msg.Index
+	}
+	return t
+}
+
+// Message 根据id获取消息, 不存在时返回nil
+func (t *Tree) Message(mid primitive.ObjectID) *Message {
+	return t.messages[mid]
+}
+
+// LastIndex 最新消息的索引, 包括未激活分支中的消息, 没有消息时为-1
+func (t *Tree) LastIndex() int32 {
+	return t.last
+}
+
+// Children 消息的子消息, 按索引正序
+func (t *Tree) Children(mid primitive.ObjectID) []*Message {
+	return t.children[mid]
+}
+
+// Siblings 消息的兄弟消息, 包括自身, 按索引正序
+func (t *Tree) Siblings(mid primitive.ObjectID) []*Message {
+	p, ok := t.parent[mid]
+	if !ok {
+		return nil
+	}
+	return t.children[p]
+}
+
+// ActivePath 当前激活的路径, 按索引倒序
+// 每组兄弟中取激活的一条, 有多条激活时取最新的
+func (t *Tree) ActivePath() []*Message {
+	var path []*Message
+	for _, sid := range t.sections {
+		for cur := sid; ; {
+			next := activeChild(t.children[cur])
+			if next == nil {
+				break
+			}
+			path, cur = append(path, next), next.MessageId
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// Activate 激活一条消息所在的分支, 包括其所有祖先消息, 同组的其他兄弟消息设为未激活, 返回状态发生变化的消息
+func (t *Tree) Activate(mid primitive.ObjectID) (changed []*Message) {
+	for cur := mid; t.messages[cur] != nil; cur = t.parent[cur] {
+		for _, msg := range t.Siblings(cur) {
+			if inactive := msg.MessageId != cur; msg.Inactive != inactive {
+				msg.Inactive = inactive
+				changed = append(changed, msg)
+			}
+		}
+	}
+	return changed
+}
+
+func activeChild(children []*Message) *Message {
+	for i := len(children) - 1; i >= 0; i-- {
+		if !children[i].Inactive {
+			return children[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package message
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 段落s中的消息树:
+//
+//	u0 ─ a1 ─ u2 ─ a3
+//	   └ a4 ─ u5 ─ a6   (a4为a1的重新生成, 当前激活)
+func buildTree() (*Tree, map[string]*Message) {
+	sid := primitive.NewObjectID()
+	ms := map[string]*Message{}
+	add := func(name string, index int32, parent string, inactive bool) {
+		m := &Message{MessageId: primitive.NewObjectID(), SectionId: sid, Index: index, Inactive: inactive}
+		if parent != "" {
+			m.ParentId = ms[parent].MessageId
+		} else {
+			m.ParentId = sid
+		}
+		ms[name] = m
+	}
+	add("u0", 0, "", false)
+	add("a1", 1, "u0", true)
+	add("u2", 2, "a1", false)
+	add("a3", 3, "u2", false)
+	add("a4", 4, "u0", false)
+	add("u5", 5, "a4", false)
+	add("a6", 6, "u5", false)
+	var msgs []*Message
+	for _, m := range ms {
+		msgs = append(msgs, m)
+	}
+	return NewTree(msgs), ms
+}
+
+func ids(msgs []*Message) (out []primitive.ObjectID) {
+	for _, m := range msgs {
+		out = append(out, m.MessageId)
+	}
+	return out
+}
+
+func TestTreeStructure(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tree, ms := buildTree()
+	g.Expect(tree.LastIndex()).To(Equal(int32(6)))
+	g.Expect(tree.Message(ms["u2"].MessageId)).To(Equal(ms["u2"]))
+	g.Expect(tree.Message(primitive.NewObjectID())).To(BeNil())
+	g.Expect(ids(tree.Children(ms["u0"].MessageId))).To(Equal(ids([]*Message{ms["a1"], ms["a4"]})))
+	g.Expect(ids(tree.Siblings(ms["a4"].MessageId))).To(Equal(ids([]*Message{ms["a1"], ms["a4"]})))
+	g.Expect(tree.Siblings(primitive.NewObjectID())).To(BeNil())
+	g.Expect(ids(tree.ActivePath())).To(Equal(ids([]*Message{ms["a6"], ms["u5"], ms["a4"], ms["u0"]})))
+}
+
+func TestTreeActivate(t *testing.T) {
+	cases := []struct {
+		name    string
+		target  string
+		path    []string // 激活后的路径, 倒序
+		changed int
+	}{
+		{"active message", "a6", []string{"a6", "u5", "a4", "u0"}, 0},
+		{"sibling", "a1", []string{"a3", "u2", "a1", "u0"}, 2},
+		{"descendant of inactive branch", "a3", []string{"a3", "u2", "a1", "u0"}, 2},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		tree, ms := buildTree()
+		changed := tree.Activate(ms[c.target].MessageId)
+		g.Expect(changed).To(HaveLen(c.changed), c.name)
+		var want []*Message
+		for _, n := range c.path {
+			want = append(want, ms[n])
+		}
+		g.Expect(ids(tree.ActivePath())).To(Equal(ids(want)), c.name)
+	}
+}
+
+// 旧版本消息没有父消息, 视为上一条消息的子消息
+func TestTreeLegacyMessages(t *testing.T) {
+	g := NewGomegaWithT(t)
+	sid := primitive.NewObjectID()
+	var msgs []*Message
+	for i := int32(0); i < 3; i++ {
+		msgs = append(msgs, &Message{MessageId: primitive.NewObjectID(), SectionId: sid, Index: i})
+	}
+	tree := NewTree([]*Message{msgs[2], msgs[0], msgs[1]})
+	g.Expect(ids(tree.ActivePath())).To(Equal(ids([]*Message{msgs[2], msgs[1], msgs[0]})))
+	g.Expect(ids(tree.Siblings(msgs[1].MessageId))).To(Equal(ids(msgs[1:2])))
+}
@@ -0,0 +1,112 @@
+package share
+
+import (
+	"context"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/application/dto/basic"
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/util"
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx"
+	"github.com/xh-polaris/innospark-core-api/pkg/logs"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var Mapper MongoMapper = (*mongoMapper)(nil)
+
+const (
+	collection = "share"
+)
+
+type MongoMapper interface {
+	Insert(ctx context.Context, s *Share) error
+	GetByToken(ctx context.Context, token string) (s *Share, err error)
+	IncrViews(ctx context.Context, sid primitive.ObjectID) error
+	ListShares(ctx context.Context, uid string, page *basic.Page) (ss []*Share, hasMore bool, err error)
+	Revoke(ctx context.Context, uid, token string) error
+	PurgeByConversation(ctx context.Context, cid primitive.ObjectID) error
+}
+
+type mongoMapper struct {
+	conn *monc.Model
+}
+
+func NewShareMongoMapper(config *conf.Config) MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, collection, config.CacheConf)
+	Mapper = &mongoMapper{conn: conn}
+	return Mapper
+}
+
+// Insert 新增一个分享
+func (m *mongoMapper) Insert(ctx context.Context, s *Share) (err error) {
+	now := time.Now()
+	if s.ShareId.IsZero() {
+		s.ShareId = primitive.NewObjectID()
+	}
+	s.CreateTime, s.UpdateTime = now, now
+	_, err = m.conn.InsertOneNoCache(ctx, s)
+	return err
+}
+
+// GetByToken 根据令牌获取未撤销的分享, 包括消息快照
+func (m *mongoMapper) GetByToken(ctx context.Context, token string) (s *Share, err error) {
+	s = &Share{}
+	err = m.conn.FindOneNoCache(ctx, s, bson.M{cst.Token: token, cst.Status: bson.M{cst.NE: cst.DeletedStatus}})
+	return s, err
+}
+
+// IncrViews 访问次数加一
+func (m *mongoMapper) IncrViews(ctx context.Context, sid primitive.ObjectID) (err error) {
+	_, err = m.conn.UpdateOneNoCache(ctx, bson.M{cst.Id: sid}, bson.M{cst.Inc: bson.M{cst.Views: 1}})
+	return err
+}
+
+// ListShares 分页查询用户创建的分享, 按创建时间倒序, 不返回消息快照
+func (m *mongoMapper) ListShares(ctx context.Context, uid string, page *basic.Page) (ss []*Share, hasMore bool, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [share] [ListShares] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return nil, false, err
+	}
+
+	opts := options.Find().SetSort(bson.M{cst.Id: -1}).SetLimit(page.GetSize() + 1).SetProjection(bson.M{cst.Messages: 0})
+	filter := bson.M{cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	if page != nil && page.Cursor != nil { // 存在cursor时, 查询创建时间小于Cursor的
+		cursor, err := primitive.ObjectIDFromHex(*page.Cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		filter[cst.Id] = bson.M{cst.LT: cursor}
+	}
+	if err = m.conn.Find(ctx, &ss, filter, opts); err != nil {
+		return nil, false, err
+	}
+	ss, hasMore = util.SplitAndHasMore(ss, page)
+	return ss, hasMore, err
+}
+
+// Revoke 撤销用户的分享, 撤销后链接不可访问
+func (m *mongoMapper) Revoke(ctx context.Context, uid, token string) (err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		logs.Errorf("[mapper] [share] [Revoke] from hex err:%s", errorx.ErrorWithoutStack(err))
+		return err
+	}
+	filter := bson.M{cst.Token: token, cst.UserId: oid, cst.Status: bson.M{cst.NE: cst.DeletedStatus}}
+	res, err := m.conn.UpdateOneNoCache(ctx, filter,
+		bson.M{cst.Set: bson.M{cst.UpdateTime: time.Now(), cst.DeleteTime: time.Now(), cst.Status: cst.DeletedStatus}})
+	if err == nil && res.MatchedCount == 0 {
+		return monc.ErrNotFound
+	}
+	return err
+}
+
+// PurgeByConversation 彻底删除对话的全部分享, 用于对话被彻底删除时
+func (m *mongoMapper) PurgeByConversation(ctx context.Context, cid primitive.ObjectID) (err error) {
+	_, err = m.conn.DeleteMany(ctx, bson.M{cst.ConversationId: cid})
+	return err
+}
@@ -0,0 +1,66 @@
+package share
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	mmsg "github.com/xh-polaris/innospark-core-api/biz/infra/mapper/message"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Share 对话分享, 保存创建时对话激活路径的不可变快照, 通过随机令牌公开访问
+type Share struct {
+	ShareId        primitive.ObjectID `json:"share_id" bson:"_id"`                                // 主键
+	Token          string             `json:"token" bson:"token"`                                 // 分享令牌, 索引
+	UserId         primitive.ObjectID `json:"user_id" bson:"user_id"`                             // 分享者id, 索引
+	ConversationId primitive.ObjectID `json:"conversation_id" bson:"conversation_id"`             // 来源对话id
+	Brief          string             `json:"brief" bson:"brief"`                                 // 分享标题
+	BotId          string             `json:"bot_id" bson:"bot_id"`                               // intelligence_id
+	Messages       []*mmsg.Message    `json:"messages,omitempty" bson:"messages,omitempty"`       // 消息快照, 按索引倒序
+	MessageCount   int32              `json:"message_count" bson:"message_count"`                 // 快照中的消息数
+	Password       string             `json:"-" bson:"password,omitempty"`                        // 访问密码的bcrypt哈希, 为空时无需密码
+	ExpireTime     time.Time          `json:"expire_time,omitempty" bson:"expire_time,omitempty"` // 过期时间, 为空时永久有效
+	Views          int64              `json:"views" bson:"views"`                                 // 访问次数
+	CreateTime     time.Time          `json:"create_time" bson:"create_time"`                     // 创建时间
+	UpdateTime     time.Time          `json:"update_time" bson:"update_time"`                     // 更新时间
+	DeleteTime     time.Time          `json:"delete_time,omitempty" bson:"delete_time,omitempty"` // 撤销时间
+	Status         int32              `json:"status" bson:"status"`                               // 状态, 撤销后为删除状态
+}
+
+// NewToken 生成分享令牌, 128位随机数的URL安全编码
+func NewToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// SetPassword 设置访问密码, 保存为bcrypt哈希
+func (s *Share) SetPassword(password string) error {
+	if password == "" {
+		s.Password = ""
+		return nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	s.Password = string(hash)
+	return nil
+}
+
+// CheckPassword 校验访问密码, 未设置密码时总是通过
+func (s *Share) CheckPassword(password string) bool {
+	if s.Password == "" {
+		return true
+	}
+	return bcrypt.CompareHashAndPassword([]byte(s.Password), []byte(password)) == nil
+}
+
+// Expired 分享是否已过期
+func (s *Share) Expired() bool {
+	return !s.ExpireTime.IsZero() && time.Now().After(s.ExpireTime)
+}
@@ -0,0 +1,44 @@
+package share
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSharePassword(t *testing.T) {
+	cases := []struct {
+		name     string
+		password string
+		input    string
+		ok       bool
+	}{
+		{"no password", "", "", true},
+		{"no password with input", "", "any", true},
+		{"correct", "p@ss", "p@ss", true},
+		{"wrong", "p@ss", "pass", false},
+		{"empty input", "p@ss", "", false},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		s := &Share{}
+		g.Expect(s.SetPassword(c.password)).To(Succeed(), c.name)
+		if c.password != "" {
+			g.Expect(s.Password).To(HavePrefix("$2"), c.name) // bcrypt哈希
+			g.Expect(s.Password).NotTo(ContainSubstring(c.password), c.name)
+		}
+		g.Expect(s.CheckPassword(c.input)).To(Equal(c.ok), c.name)
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect((&Share{}).SetPassword(strings.Repeat("a", 73))).NotTo(Succeed()) // 超过bcrypt的长度上限
+}
+
+func TestShareExpired(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect((&Share{}).Expired()).To(BeFalse())
+	g.Expect((&Share{ExpireTime: time.Now().Add(time.Hour)}).Expired()).To(BeFalse())
+	g.Expect((&Share{ExpireTime: time.Now().Add(-time.Hour)}).Expired()).To(BeTrue())
+}
@@ -0,0 +1,112 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/conf"
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	collection       = "usage"
+	rollupCollection = "usage_daily"
+)
+
+type MongoMapper interface {
+	Insert(ctx context.Context, r *Record) error
+	Statistics(ctx context.Context, opt *StatOption) (stats []*Stat, err error)
+	TopUsers(ctx context.Context, opt *StatOption, limit int64) (stats []*Stat, err error)
+}
+
+type mongoMapper struct {
+	conn   *monc.Model
+	rollup *monc.Model
+}
+
+func NewUsageMongoMapper(config *conf.Config) MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, collection, config.CacheConf)
+	rollup := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, rollupCollection, config.CacheConf)
+	return &mongoMapper{conn: conn, rollup: rollup}
+}
+
+// Insert 写入一条流水, 并累加到当天的汇总中
+func (m *mongoMapper) Insert(ctx context.Context, r *Record) (err error) {
+	if r.RecordId.IsZero() {
+		r.RecordId = primitive.NewObjectID()
+	}
+	if r.CreateTime.IsZero() {
+		r.CreateTime = time.Now()
+	}
+	if _, err = m.conn.InsertOneNoCache(ctx, r); err != nil {
+		return err
+	}
+	filter := bson.M{cst.Date: Day(r.CreateTime), cst.UserId: r.UserId, cst.Model: r.Model, cst.BotId: r.BotId}
+	update := bson.M{cst.Inc: bson.M{
+		cst.Requests:       1,
+		cst.PromptTokens:   r.PromptTokens,
+		cst.CompleteTokens: r.CompletionTokens,
+		cst.CachedTokens:   r.CachedTokens,
+		cst.TotalTokens:    r.TotalTokens,
+		cst.SearchCalls:    r.SearchCalls,
+		cst.OCRCalls:       r.OCRCalls,
+		cst.Latency:        r.Latency,
+	}}
+	_, err = m.rollup.UpdateOneNoCache(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+	return err
+}
+
+// Statistics 按维度分组统计汇总中的用量, 按天分组时按日期正序, 其余按总token数倒序
+func (m *mongoMapper) Statistics(ctx context.Context, opt *StatOption) (stats []*Stat, err error) {
+	field, sort := "$"+cst.Date, bson.D{{Key: cst.Id, Value: 1}}
+	switch opt.GroupBy {
+	case GroupByModel:
+		field = "$" + cst.Model
+	case GroupByUser:
+		field = "$" + cst.UserId
+	case GroupByBot:
+		field = "$" + cst.BotId
+	}
+	if field != "$"+cst.Date {
+		sort = bson.D{{Key: cst.TotalTokens, Value: -1}, {Key: cst.Id, Value: 1}}
+	}
+	err = m.rollup.Aggregate(ctx, &stats, pipeline(opt, field, sort, 0))
+	return stats, err
+}
+
+// TopUsers 用量最多的用户, 按总token数倒序
+func (m *mongoMapper) TopUsers(ctx context.Context, opt *StatOption, limit int64) (stats []*Stat, err error) {
+	sort := bson.D{{Key: cst.TotalTokens, Value: -1}, {Key: cst.Id, Value: 1}}
+	err = m.rollup.Aggregate(ctx, &stats, pipeline(opt, "$"+cst.UserId, sort, limit))
+	return stats, err
+}
+
+// pipeline 筛选、分组、排序并整理分组键, 按天分组的键保留为日期, 其余转换为字符串
+func pipeline(opt *StatOption, field string, sort bson.D, limit int64) bson.A {
+	match := bson.M{cst.Date: bson.M{cst.GTE: Day(opt.Start), cst.LTE: Day(opt.End)}}
+	if opt.Model != "" {
+		match[cst.Model] = opt.Model
+	}
+	if opt.BotId != "" {
+		match[cst.BotId] = opt.BotId
+	}
+	if oid, err := primitive.ObjectIDFromHex(opt.UserId); err == nil {
+		match[cst.UserId] = oid
+	}
+	group := bson.M{cst.Id: field}
+	for _, f := range []string{cst.Requests, cst.PromptTokens, cst.CompleteTokens, cst.CachedTokens, cst.TotalTokens, cst.SearchCalls, cst.OCRCalls, cst.Latency} {
+		group[f] = bson.M{cst.Sum: "$" + f}
+	}
+	p := bson.A{bson.M{cst.Match: match}, bson.M{cst.Group: group}, bson.M{cst.Sort: sort}}
+	if limit > 0 {
+		p = append(p, bson.M{cst.Limit: limit})
+	}
+	if field == "$"+cst.Date {
+		return append(p, bson.M{cst.Set: bson.M{cst.Date: "$" + cst.Id}})
+	}
+	return append(p, bson.M{cst.Set: bson.M{cst.Key: bson.M{cst.ToString: "$" + cst.Id}}})
+}
@@ -0,0 +1,86 @@
+package usage
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	SourceCompletions = "completions" // 对话接口
+	SourceGateway     = "gateway"     // OpenAI兼容接口
+	SourceSummary     = "summary"     // 对话结束后生成摘要
+	SourceMemory      = "memory"      // 对话结束后提取用户记忆
+	SourceTitle       = "title"       // 生成或更新对话标题
+
+	GroupByDay   = "day"
+	GroupByModel = "model"
+	GroupByUser  = "user"
+	GroupByBot   = "bot"
+)
+
+// Record 一次模型调用的用量流水
+type Record struct {
+	RecordId         primitive.ObjectID `json:"record_id" bson:"_id"`                                       // 主键
+	UserId           primitive.ObjectID `json:"user_id" bson:"user_id"`                                     // 用户id
+	ConversationId   primitive.ObjectID `json:"conversation_id,omitempty" bson:"conversation_id,omitempty"` // 对话id, OpenAI兼容接口为空
+	MessageId        primitive.ObjectID `json:"message_id,omitempty" bson:"message_id,omitempty"`           // 模型消息id
+	KeyId            primitive.ObjectID `json:"key_id,omitempty" bson:"key_id,omitempty"`                   // 使用的API密钥
+	Source           string             `json:"source" bson:"source"`                                       // 调用来源
+	Model            string             `json:"model" bson:"model"`                                         // 实际调用的模型
+	BotId            string             `json:"bot_id,omitempty" bson:"bot_id,omitempty"`                   // 智能体id
+	PromptTokens     int64              `json:"prompt_tokens" bson:"prompt_tokens"`                         // 输入token数
+	CompletionTokens int64              `json:"completion_tokens" bson:"completion_tokens"`                 // 输出token数
+	CachedTokens     int64              `json:"cached_tokens" bson:"cached_tokens"`                         // 命中缓存的输入token数
+	TotalTokens      int64              `json:"total_tokens" bson:"total_tokens"`                           // 总token数
+	Estimated        bool               `json:"estimated,omitempty" bson:"estimated,omitempty"`             // 模型未返回用量时为估算值
+	SearchCalls      int64              `json:"search_calls" bson:"search_calls"`                           // 搜索次数
+	OCRCalls         int64              `json:"ocr_calls" bson:"ocr_calls"`                                 // ocr次数
+	Latency          int64              `json:"latency" bson:"latency"`                                     // 耗时, 毫秒
+	CreateTime       time.Time          `json:"create_time" bson:"create_time"`                             // 创建时间
+}
+
+// Rollup 按天、用户、模型与智能体汇总的用量, 写入流水时同步累加
+type Rollup struct {
+	Date             time.Time          `json:"date" bson:"date"` // 当天0点
+	UserId           primitive.ObjectID `json:"user_id" bson:"user_id"`
+	Model            string             `json:"model" bson:"model"`
+	BotId            string             `json:"bot_id" bson:"bot_id"`
+	Requests         int64              `json:"requests" bson:"requests"` // 调用次数
+	PromptTokens     int64              `json:"prompt_tokens" bson:"prompt_tokens"`
+	CompletionTokens int64              `json:"completion_tokens" bson:"completion_tokens"`
+	CachedTokens     int64              `json:"cached_tokens" bson:"cached_tokens"`
+	TotalTokens      int64              `json:"total_tokens" bson:"total_tokens"`
+	SearchCalls      int64              `json:"search_calls" bson:"search_calls"`
+	OCRCalls         int64              `json:"ocr_calls" bson:"ocr_calls"`
+	Latency          int64              `json:"latency" bson:"latency"` // 耗时之和, 毫秒
+}
+
+// StatOption 用量统计的条件, 时间范围按天对齐
+type StatOption struct {
+	Start   time.Time
+	End     time.Time
+	GroupBy string // 分组维度, 为空时按天
+	Model   string // 只统计该模型
+	UserId  string // 只统计该用户
+	BotId   string // 只统计该智能体
+}
+
+// Stat 一个分组的用量
+type Stat struct {
+	Date             time.Time `bson:"date,omitempty"` // 按天分组时的日期
+	Key              string    `bson:"key,omitempty"`  // 按其他维度分组时的值
+	Requests         int64     `bson:"requests"`
+	PromptTokens     int64     `bson:"prompt_tokens"`
+	CompletionTokens int64     `bson:"completion_tokens"`
+	CachedTokens     int64     `bson:"cached_tokens"`
+	TotalTokens      int64     `bson:"total_tokens"`
+	SearchCalls      int64     `bson:"search_calls"`
+	OCRCalls         int64     `bson:"ocr_calls"`
+	Latency          int64     `bson:"latency"`
+}
+
+// Day 时间所在当天的0点
+func Day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -33,6 +33,7 @@ type MongoMapper interface {
 	UnForbidden(ctx context.Context, id string) error
 	ListUser(ctx context.Context, page *basic.Page, status, sortedBy, reverse int32) (int64, []*User, error)
 	CountUserByCreateTime(ctx context.Context, time time.Time, after bool) (int64, error)
+	CountByPeriod(ctx context.Context, start, end time.Time, period, tz string) ([]*util.DateCount, error)
 
 	UpdateField(ctx context.Context, uid primitive.ObjectID, update bson.M) error
 	existField(ctx context.Context, field string, value interface{}) (bool, error)
@@ -201,3 +202,14 @@ func (m *mongoMapper) CountUserByCreateTime(ctx context.Context, t time.Time, af
 	total, err := m.conn.CountDocuments(ctx, filter)
 	return total, err
 }
+
+// CountByPeriod 按注册时间所在周期统计[start, end)内的新增用户数, 按日期正序
+func (m *mongoMapper) CountByPeriod(ctx context.Context, start, end time.Time, period, tz string) (counts []*util.DateCount, err error) {
+	pipeline := bson.A{
+		bson.M{cst.Match: bson.M{cst.CreateTime: bson.M{cst.GTE: start, cst.LT: end}}},
+		bson.M{cst.Group: bson.M{cst.Id: util.PeriodOf("$"+cst.CreateTime, period, tz), "count": bson.M{cst.Sum: 1}}},
+		bson.M{cst.Sort: bson.M{cst.Id: 1}},
+	}
+	err = m.conn.Aggregate(ctx, &counts, pipeline)
+	return counts, err
+}
@@ -21,6 +21,7 @@ type User struct {
 	Warnings   int32              `json:"warnings" bson:"warnings"`                 // 违规次数
 	Status     int32              `json:"status" bson:"status"`                     // 状态
 	Expire     time.Time          `json:"expire,omitempty" bson:"expire,omitempty"` // 封禁到期时间
+	Tier       string             `json:"tier,omitempty" bson:"tier,omitempty"`     // 用户等级, 决定限流与配额, 为空时使用default
 	LoginTime  time.Time          `json:"login_time" bson:"login_time"`             // 最近登录时间
 	CreateTime time.Time          `json:"create_time" bson:"create_time"`
 	UpdateTime time.Time          `json:"update_time" bson:"update_time"`
@@ -31,4 +32,11 @@ type Profile struct {
 	Role    *string `json:"role,omitempty" bson:"role,omitempty"`       // 角色设定
 	Grade   *string `json:"grade,omitempty" bson:"grade,omitempty"`     // 年级
 	Subject *string `json:"subject,omitempty" bson:"subject,omitempty"` // 学科
+
+	DisableMemory *bool `json:"disable_memory,omitempty" bson:"disable_memory,omitempty"` // 关闭用户记忆
+}
+
+// MemoryDisabled 用户是否关闭了记忆
+func (p *Profile) MemoryDisabled() bool {
+	return p != nil && p.DisableMemory != nil && *p.DisableMemory
 }
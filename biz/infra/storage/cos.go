@@ -2,8 +2,10 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/tencentyun/cos-go-sdk-v5"
@@ -17,7 +19,10 @@ var _ COS = (*cosClient)(nil)
 type COS interface {
 	Upload(ctx context.Context, key string, r io.Reader, opt *cos.ObjectPutOptions) (*cos.Response, error)
 	GenPresignURL(ctx context.Context, key string, opt *cos.PresignedURLOptions) (string, error)
+	GenDownloadURL(ctx context.Context, key string, expire time.Duration) (string, error)
 	GetPermanentAccessURL(key string) string
+	KeyFromURL(url string) (key string, ok bool)
+	Delete(ctx context.Context, keys ...string) error
 }
 
 type cosClient struct {
@@ -61,10 +66,52 @@ func (c *cosClient) GenPresignURL(ctx context.Context, key string, opt *cos.Pres
 	return u.String(), nil
 }
 
+// GenDownloadURL 生成对象的预签名下载url, expire为有效期
+func (c *cosClient) GenDownloadURL(ctx context.Context, key string, expire time.Duration) (string, error) {
+	u, err := c.Client.Object.GetPresignedURL2(ctx, http.MethodGet, key, expire, &cos.PresignedURLOptions{})
+	if err != nil || u == nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
 func (c *cosClient) GetPermanentAccessURL(key string) string {
 	return c.Client.Object.GetObjectURL(key).String()
 }
 
+// KeyFromURL 从存储桶或CDN的访问链接中解析对象键, 不属于本存储桶的链接返回false
+func (c *cosClient) KeyFromURL(url string) (key string, ok bool) {
+	for _, base := range []string{c.Conf.BucketURL, c.Conf.CDN} {
+		if base = strings.TrimSuffix(base, "/"); base != "" && strings.HasPrefix(url, base+"/") {
+			key, _, _ = strings.Cut(strings.TrimPrefix(url, base+"/"), "?")
+			return key, key != ""
+		}
+	}
+	return "", false
+}
+
+// Delete 批量删除对象, 对象不存在时视为成功
+func (c *cosClient) Delete(ctx context.Context, keys ...string) error {
+	for len(keys) > 0 {
+		n := min(len(keys), 1000) // 单次批量删除最多1000个对象
+		opt := &cos.ObjectDeleteMultiOptions{Quiet: true}
+		for _, key := range keys[:n] {
+			opt.Objects = append(opt.Objects, cos.Object{Key: key})
+		}
+		res, _, err := c.Client.Object.DeleteMulti(ctx, opt)
+		if err != nil {
+			return err
+		}
+		for _, e := range res.Errors {
+			if e.Code != "NoSuchKey" {
+				return fmt.Errorf("delete object %s error: %s", e.Key, e.Message)
+			}
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
 func newcosClient(c *conf.Config) *cosClient {
 	b := &cos.BaseURL{
 		BucketURL: util.Str2URL(c.COS.BucketURL), // 访问 bucket, object 相关 API 的基础 URL（不包含 path 部分）
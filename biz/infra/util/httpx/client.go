@@ -135,6 +135,38 @@ func (c *HttpClient) Post(ctx context.Context, url string, headers http.Header,
 	return c.Req(ctx, POST, url, headers, body)
 }
 
+// Fetch 获取原始响应体, 不序列化请求体, 响应体超过limit时截断, truncated标识是否截断
+func (c *HttpClient) Fetch(ctx context.Context, url string, headers http.Header, limit int64) (header http.Header, body []byte, truncated bool, err error) {
+	var req *http.Request
+	var response *http.Response
+	if req, err = http.NewRequestWithContext(ctx, GET, url, nil); err != nil {
+		return nil, nil, false, fmt.Errorf("创建请求失败: %w", err)
+	}
+	for k, vv := range headers {
+		req.Header[k] = vv
+	}
+	if response, err = c.Client.Do(req); err != nil {
+		return nil, nil, false, fmt.Errorf("[httpx] 发送请求失败: %w", err)
+	}
+	defer func() {
+		if closeErr := response.Body.Close(); closeErr != nil {
+			logs.Errorf("[httpx] 关闭请求失败: %s", errorx.ErrorWithoutStack(closeErr))
+		}
+	}()
+	// 检查响应状态码
+	if err = checkStatusCode(response); err != nil {
+		return response.Header, nil, false, err
+	}
+	// 多读取一个字节用于判断是否超过限制
+	if body, err = io.ReadAll(io.LimitReader(response.Body, limit+1)); err != nil {
+		return response.Header, nil, false, fmt.Errorf("读取响应失败: %w", err)
+	}
+	if int64(len(body)) > limit {
+		return response.Header, body[:limit], true, nil
+	}
+	return response.Header, body, false, nil
+}
+
 // StreamWithHeader 流式HTTP请求. 返回请求头
 func (c *HttpClient) StreamWithHeader(ctx context.Context, method, url string, headers http.Header, body interface{}) (http.Header, *StreamReader, error) {
 	resp, err := c.do(ctx, method, url, headers, body)
@@ -174,3 +174,21 @@ func PurifyJson(raw string) string {
 
 	return s
 }
+
+var titleBrackets = regexp.MustCompile(`[\[(（][^]）)]*[]）)]`)
+
+// CleanTitle 清洗模型生成的标题, 去除引号、括号中的说明与多余的行, 最多保留n个字符
+func CleanTitle(s string, n int) string {
+	s, _, _ = strings.Cut(strings.TrimSpace(s), "\n")
+	s = strings.Trim(strings.TrimSpace(s), "\"'“”《》")
+	s = strings.TrimSpace(titleBrackets.ReplaceAllString(s, ""))
+	return TruncateRunes(s, n)
+}
+
+// TruncateRunes 截取字符串的前n个字符(rune)
+func TruncateRunes(s string, n int) string {
+	if rs := []rune(s); len(rs) > n {
+		return string(rs[:n])
+	}
+	return s
+}
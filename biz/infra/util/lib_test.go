@@ -0,0 +1,37 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCleanTitle(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"  Go语言入门  ", "Go语言入门"},
+		{"\"Go语言入门\"", "Go语言入门"},
+		{"“Go语言入门”", "Go语言入门"},
+		{"《红楼梦》读后感", "红楼梦》读后感"},
+		{"Go语言入门\n以上是标题", "Go语言入门"},
+		{"Go语言入门(不超过15字)", "Go语言入门"},
+		{"Go语言入门（标题）", "Go语言入门"},
+		{"[标题] Go语言入门", "Go语言入门"},
+		{strings.Repeat("长", 40), strings.Repeat("长", 30)},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(CleanTitle(c.in, 30)).To(Equal(c.want), c.in)
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(TruncateRunes("中文标题", 2)).To(Equal("中文"))
+	g.Expect(TruncateRunes("abc", 3)).To(Equal("abc"))
+	g.Expect(TruncateRunes("", 3)).To(Equal(""))
+}
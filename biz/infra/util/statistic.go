@@ -0,0 +1,45 @@
+package util
+
+import (
+	"time"
+
+	"github.com/xh-polaris/innospark-core-api/biz/infra/cst"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// 统计聚合中按时区划分的时间周期, 分组键为周期第一天的日期字符串
+const (
+	PeriodDay   = "day"
+	PeriodWeek  = "week" // 以周一为一周的开始
+	PeriodMonth = "month"
+
+	DateLayout = "2006-01-02"
+)
+
+// DateCount 按周期分组的计数
+type DateCount struct {
+	Date  string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+// PeriodOf 返回时间字段在指定时区下所属周期的第一天, 形如"2006-01-02"
+func PeriodOf(field, period, tz string) bson.M {
+	date, format := any(field), "%Y-%m-%d"
+	switch period {
+	case PeriodWeek: // 回退到当周周一
+		offset := bson.M{"$subtract": bson.A{bson.M{"$isoDayOfWeek": bson.M{"date": field, "timezone": tz}}, 1}}
+		date = bson.M{"$subtract": bson.A{field, bson.M{"$multiply": bson.A{offset, int64(24 * time.Hour / time.Millisecond)}}}}
+	case PeriodMonth:
+		format = "%Y-%m-01"
+	}
+	return bson.M{cst.DateToString: bson.M{"format": format, "date": date, "timezone": tz}}
+}
+
+// ParseDate 将PeriodOf得到的日期解析为该时区当天0点的时间戳
+func ParseDate(date string, loc *time.Location) int64 {
+	t, err := time.ParseInLocation(DateLayout, date, loc)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
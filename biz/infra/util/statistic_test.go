@@ -0,0 +1,51 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestPeriodOf(t *testing.T) {
+	dayMs := int64(24 * time.Hour / time.Millisecond)
+	cases := []struct {
+		name   string
+		period string
+		want   bson.M
+	}{
+		{"day", PeriodDay, bson.M{"$dateToString": bson.M{"format": "%Y-%m-%d", "date": "$create_time", "timezone": "Asia/Shanghai"}}},
+		{"unknown as day", "year", bson.M{"$dateToString": bson.M{"format": "%Y-%m-%d", "date": "$create_time", "timezone": "Asia/Shanghai"}}},
+		{"month", PeriodMonth, bson.M{"$dateToString": bson.M{"format": "%Y-%m-01", "date": "$create_time", "timezone": "Asia/Shanghai"}}},
+		{"week", PeriodWeek, bson.M{"$dateToString": bson.M{"format": "%Y-%m-%d", "timezone": "Asia/Shanghai",
+			"date": bson.M{"$subtract": bson.A{"$create_time", bson.M{"$multiply": bson.A{
+				bson.M{"$subtract": bson.A{bson.M{"$isoDayOfWeek": bson.M{"date": "$create_time", "timezone": "Asia/Shanghai"}}, 1}}, dayMs}}}},
+		}}},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(PeriodOf("$create_time", c.period, "Asia/Shanghai")).To(Equal(c.want), c.name)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	newYork, _ := time.LoadLocation("America/New_York")
+	cases := []struct {
+		name string
+		date string
+		loc  *time.Location
+		want int64
+	}{
+		{"utc", "2025-01-02", time.UTC, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC).Unix()},
+		{"shanghai", "2025-01-02", shanghai, time.Date(2025, 1, 1, 16, 0, 0, 0, time.UTC).Unix()},
+		{"dst", "2025-03-09", newYork, time.Date(2025, 3, 9, 5, 0, 0, 0, time.UTC).Unix()},
+		{"invalid", "2025/01/02", time.UTC, 0},
+		{"empty", "", time.UTC, 0},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(ParseDate(c.date, c.loc)).To(Equal(c.want), c.name)
+	}
+}
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"unicode"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+const ImageTokens = 1000 // 单张图片的估算token数
+
+// EstimateTokens 粗略估算文本的token数, 中日韩字符按一个token计, 其余按四个字符一个token计
+func EstimateTokens(s string) int {
+	cjk, other := 0, 0
+	for _, r := range s {
+		if IsCJK(r) {
+			cjk++
+		} else {
+			other++
+		}
+	}
+	return cjk + (other+3)/4
+}
+
+// EstimateMessageTokens 估算消息的token数, 包括文本、图片与工具调用
+func EstimateMessageTokens(m *schema.Message) (tokens int) {
+	tokens = EstimateTokens(m.Content) + EstimateTokens(m.ReasoningContent)
+	for _, p := range m.UserInputMultiContent {
+		switch p.Type {
+		case schema.ChatMessagePartTypeText:
+			tokens += EstimateTokens(p.Text)
+		case schema.ChatMessagePartTypeImageURL:
+			tokens += ImageTokens
+		}
+	}
+	for _, tc := range m.ToolCalls {
+		tokens += EstimateTokens(tc.Function.Name) + EstimateTokens(tc.Function.Arguments)
+	}
+	return tokens
+}
+
+// IsCJK 判断是否为中日韩字符
+func IsCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
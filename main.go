@@ -49,6 +49,9 @@ func Init() {
 func main() {
 	Init()
 	c := conf.GetConfig()
+	// 启动后台任务, 服务关闭时停止
+	ctx, cancel := context.WithCancel(context.Background())
+	application.StartJobs(ctx)
 
 	// 创建服务器追踪器
 	tracer, cfg := tracing.NewServerTracer()
@@ -60,6 +63,7 @@ func main() {
 	)
 
 	h.NoHijackConnPool = true
+	h.OnShutdown = append(h.OnShutdown, func(context.Context) { cancel() })
 
 	corsConf := cors.DefaultConfig()
 	corsConf.AllowAllOrigins = true
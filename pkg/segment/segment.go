@@ -0,0 +1,193 @@
+package segment
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// 面向中英文混合文本的轻量分词, 用于消息的全文检索
+// 连续的中日韩字符切分为单字与相邻二元组, 字母与数字按连续片段切分为小写单词, 其余字符作为分隔符
+// 建索引时单字与二元组都会保留, 检索时单个汉字使用单字, 多个汉字使用二元组, 从而支持任意长度的中文关键词
+
+// Terms 文本建索引的全部词项, 去重且有序
+func Terms(text string) []string {
+	set := TermSet(text)
+	terms := make([]string, 0, len(set))
+	for t := range set {
+		terms = append(terms, t)
+	}
+	sort.Strings(terms)
+	return terms
+}
+
+// TermSet 文本建索引的全部词项集合, 用于在内存中计算与检索词项的重合
+func TermSet(text string) map[string]bool {
+	set := map[string]bool{}
+	for _, run := range runs(text) {
+		if !run.cjk {
+			set[run.text] = true
+			continue
+		}
+		rs := []rune(run.text)
+		for i := range rs {
+			set[string(rs[i])] = true
+			if i+1 < len(rs) {
+				set[string(rs[i:i+2])] = true
+			}
+		}
+	}
+	return set
+}
+
+// QueryTerms 检索关键词对应的词项, 文档需要包含全部词项才算命中
+func QueryTerms(key string) []string {
+	set, terms := map[string]struct{}{}, []string(nil)
+	add := func(t string) {
+		if _, ok := set[t]; !ok {
+			set[t] = struct{}{}
+			terms = append(terms, t)
+		}
+	}
+	for _, run := range runs(key) {
+		rs := []rune(run.text)
+		if !run.cjk || len(rs) == 1 {
+			add(run.text)
+			continue
+		}
+		for i := 0; i+1 < len(rs); i++ {
+			add(string(rs[i : i+2]))
+		}
+	}
+	return terms
+}
+
+// Words 检索关键词中的词, 即连续的中文片段与英文单词, 用于高亮
+func Words(key string) []string {
+	var words []string
+	for _, run := range runs(key) {
+		words = append(words, run.text)
+	}
+	return words
+}
+
+// Highlight 高亮片段在摘要中的位置, 以字符(rune)为单位
+type Highlight struct {
+	Start  int
+	Length int
+}
+
+// Snippet 截取文本中第一个关键词附近约width个字符作为摘要, 并返回摘要中所有关键词的位置, 匹配不区分大小写
+// 完整的词没有出现时(例如中文关键词被标点隔开), 退化为高亮其中的二元组与单字; 没有任何匹配时返回文本开头
+func Snippet(text string, words []string, width int) (string, []Highlight) {
+	rs, lower := []rune(text), []rune(strings.ToLower(text))
+	if len(lower) != len(rs) { // 极少数字符小写后长度变化, 不区分大小写会导致位置错乱
+		lower = rs
+	}
+	marks := match(lower, words)
+	if len(marks) == 0 {
+		var parts []string
+		for _, w := range words {
+			parts = append(parts, QueryTerms(w)...)
+		}
+		marks = match(lower, parts)
+	}
+
+	start := 0
+	if len(marks) > 0 {
+		start = marks[0].Start - width/4 // 关键词前保留少量上下文
+	}
+	start = max(0, min(start, len(rs)-width))
+	end := min(len(rs), start+width)
+
+	var hs []Highlight
+	for _, h := range marks {
+		if h.Start >= start && h.Start+h.Length <= end {
+			hs = append(hs, Highlight{Start: h.Start - start, Length: h.Length})
+		}
+	}
+	snippet := string(rs[start:end])
+	if start > 0 {
+		snippet = "..." + snippet
+		for i := range hs {
+			hs[i].Start += 3
+		}
+	}
+	if end < len(rs) {
+		snippet += "..."
+	}
+	return snippet, hs
+}
+
+// match 找出所有关键词出现的位置, 重叠的位置合并, 按位置排序
+func match(text []rune, words []string) []Highlight {
+	covered := make([]bool, len(text))
+	for _, w := range words {
+		wr := []rune(strings.ToLower(w))
+		if len(wr) == 0 {
+			continue
+		}
+		for i := 0; i+len(wr) <= len(text); i++ {
+			if string(text[i:i+len(wr)]) == string(wr) {
+				for j := i; j < i+len(wr); j++ {
+					covered[j] = true
+				}
+			}
+		}
+	}
+	var hs []Highlight
+	for i := 0; i < len(covered); i++ {
+		if !covered[i] {
+			continue
+		}
+		j := i
+		for j < len(covered) && covered[j] {
+			j++
+		}
+		hs = append(hs, Highlight{Start: i, Length: j - i})
+		i = j
+	}
+	return hs
+}
+
+type run struct {
+	text string
+	cjk  bool
+}
+
+// runs 将文本切分为连续的中日韩字符片段与小写的字母数字片段
+func runs(text string) []run {
+	var (
+		rs   []run
+		cur  []rune
+		kind bool
+	)
+	flush := func() {
+		if len(cur) > 0 {
+			rs = append(rs, run{text: string(cur), cjk: kind})
+			cur = cur[:0]
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			if !kind {
+				flush()
+			}
+			cur, kind = append(cur, r), true
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if kind {
+				flush()
+			}
+			cur, kind = append(cur, r), false
+		default:
+			flush()
+		}
+	}
+	flush()
+	return rs
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
@@ -0,0 +1,103 @@
+package segment
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTerms(t *testing.T) {
+	cases := []struct {
+		text string
+		want []string
+	}{
+		{"", []string{}},
+		{"，。!", []string{}},
+		{"Hello World", []string{"hello", "world"}},
+		{"中文", []string{"中", "中文", "文"}},
+		{"Go语言v2", []string{"go", "v2", "言", "语", "语言"}},
+		{"你好, 你好", []string{"你", "你好", "好"}},
+		{"カタカナ", []string{"カ", "カタ", "カナ", "タ", "タカ", "ナ"}},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(Terms(c.text)).To(Equal(c.want), c.text)
+	}
+}
+
+func TestTermSet(t *testing.T) {
+	cases := []struct {
+		text  string
+		query string
+		hits  int
+	}{
+		{"用户喜欢Python编程", "python", 1},
+		{"用户喜欢Python编程", "编程语言", 1},
+		{"用户喜欢Python编程", "喜欢编程", 2},
+		{"用户是高中数学老师", "数学", 1},
+		{"用户是高中数学老师", "英语", 0},
+		{"", "数学", 0},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		set, hits := TermSet(c.text), 0
+		for _, term := range QueryTerms(c.query) {
+			if set[term] {
+				hits++
+			}
+		}
+		g.Expect(hits).To(Equal(c.hits), c.text+"|"+c.query)
+		g.Expect(set).To(HaveLen(len(Terms(c.text))), c.text)
+	}
+}
+
+func TestQueryTerms(t *testing.T) {
+	cases := []struct {
+		key  string
+		want []string
+	}{
+		{"", nil},
+		{"中", []string{"中"}},
+		{"中文", []string{"中文"}},
+		{"中文检索", []string{"中文", "文检", "检索"}},
+		{"GO 语言 go", []string{"go", "语言"}},
+		{"哈哈哈", []string{"哈哈"}},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(QueryTerms(c.key)).To(Equal(c.want), c.key)
+		// 查询词项都包含在文本的索引词项中
+		g.Expect(Terms(c.key)).To(ContainElements(c.want), c.key)
+	}
+}
+
+func TestWords(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(Words("Go语言, 全文检索!")).To(Equal([]string{"go", "语言", "全文检索"}))
+	g.Expect(Words("  ")).To(BeNil())
+}
+
+func TestSnippet(t *testing.T) {
+	cases := []struct {
+		name    string
+		text    string
+		words   []string
+		width   int
+		snippet string
+		hs      []Highlight
+	}{
+		{"no match", "abcdefghij", []string{"xyz"}, 4, "abcd...", nil},
+		{"short text", "Hello Go", []string{"go"}, 20, "Hello Go", []Highlight{{Start: 6, Length: 2}}},
+		{"case insensitive", "GO go", []string{"Go"}, 10, "GO go", []Highlight{{Start: 0, Length: 2}, {Start: 3, Length: 2}}},
+		{"overlap merged", "中文检索", []string{"中文", "文检"}, 10, "中文检索", []Highlight{{Start: 0, Length: 3}}},
+		{"fallback to bigrams", "中文，检索", []string{"中文检索"}, 10, "中文，检索", []Highlight{{Start: 0, Length: 2}, {Start: 3, Length: 2}}},
+		{"window", "0123456789关键词0123456789", []string{"关键词"}, 8, "...89关键词012...", []Highlight{{Start: 5, Length: 3}}},
+		{"window at end", "0123456789关键词", []string{"关键词"}, 8, "...56789关键词", []Highlight{{Start: 8, Length: 3}}},
+	}
+	for _, c := range cases {
+		g := NewGomegaWithT(t)
+		snippet, hs := Snippet(c.text, c.words, c.width)
+		g.Expect(snippet).To(Equal(c.snippet), c.name)
+		g.Expect(hs).To(Equal(c.hs), c.name)
+	}
+}
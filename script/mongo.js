@@ -2,4 +2,77 @@
 db.conversation.createIndex({ brief: "text" })
 
 // 查看索引
-db.conversation.getIndexes()
\ No newline at end of file
+db.conversation.getIndexes()
+
+// 消息全文检索使用的词项索引, 检索时按用户过滤并按id倒序分页
+db.message.createIndex({ user_id: 1, terms: 1, _id: -1 })
+db.message.createIndex({ user_id: 1, think_terms: 1, _id: -1 })
+
+// 为历史消息补全检索词项, 分词规则与 pkg/segment 保持一致:
+// 连续的中日韩字符切分为单字与相邻二元组, 字母与数字按连续片段切分为小写单词
+function segmentTerms(text) {
+    const set = new Set()
+    const cjk = "\\p{Script=Han}\\p{Script=Hiragana}\\p{Script=Katakana}\\p{Script=Hangul}"
+    const runs = (text || "").toLowerCase().match(new RegExp("[" + cjk + "]+|(?:(?![" + cjk + "])[\\p{L}\\p{Nd}])+", "gu")) || []
+    for (const run of runs) {
+        if (!new RegExp("^[" + cjk + "]", "u").test(run)) {
+            set.add(run)
+            continue
+        }
+        const rs = Array.from(run)
+        for (let i = 0; i < rs.length; i++) {
+            set.add(rs[i])
+            if (i + 1 < rs.length) set.add(rs[i] + rs[i + 1])
+        }
+    }
+    return Array.from(set).sort()
+}
+
+db.message.find({ terms: { $exists: false }, "ext.sensitive": { $ne: true } }).forEach(function (m) {
+    const texts = [m.content || ""]
+    ;(m.user_input_multi_content || []).concat(m.assistant_gen_multi_content || []).forEach(function (p) {
+        if (p.type === "text" && p.text) texts.push(p.text)
+    })
+    if (m.ext && m.ext.ocr) texts.push(m.ext.ocr)
+    const set = { terms: segmentTerms(texts.join("\n")) }
+    if (m.ext && m.ext.think) set.think_terms = segmentTerms(m.ext.think)
+    db.message.updateOne({ _id: m._id }, { $set: set })
+})
+
+// 分享, 公开访问时按令牌查询, 列表按用户查询, 对话彻底删除时按对话清理
+db.share.createIndex({ token: 1 }, { unique: true })
+db.share.createIndex({ user_id: 1, status: 1, _id: -1 })
+db.share.createIndex({ conversation_id: 1 })
+
+// 对话列表的筛选与排序, 置顶排在前面时先按 pinned 排序
+db.conversation.createIndex({ user_id: 1, pinned: -1, _id: -1 })
+db.conversation.createIndex({ user_id: 1, pinned: -1, update_time: -1, _id: -1 })
+db.conversation.createIndex({ user_id: 1, folder_id: 1, _id: -1 })
+db.conversation.createIndex({ user_id: 1, tags: 1, _id: -1 })
+
+// 用户的文件夹
+db.folder.createIndex({ user_id: 1, _id: 1 })
+
+// 回收站列表与过期对话的定时清理
+db.conversation.createIndex({ user_id: 1, status: 1, delete_time: -1, _id: -1 })
+db.conversation.createIndex({ status: 1, delete_time: 1 })
+db.message.createIndex({ conversation_id: 1 })
+// 彻底删除对话时检查附件是否仍被其他对话引用, $or的每个分支都需要索引
+db.message.createIndex({ "ext.attach_info.access_url": 1 }, { sparse: true })
+db.message.createIndex({ "user_input_multi_content.image.messagepartcommon.url": 1 }, { sparse: true })
+db.message.createIndex({ "user_input_multi_content.audio.messagepartcommon.url": 1 }, { sparse: true })
+db.message.createIndex({ "user_input_multi_content.video.messagepartcommon.url": 1 }, { sparse: true })
+db.message.createIndex({ "user_input_multi_content.file.messagepartcommon.url": 1 }, { sparse: true })
+
+// API密钥, 鉴权时按哈希查询, 列表按用户查询
+db.apikey.createIndex({ hash: 1 }, { unique: true })
+db.apikey.createIndex({ user_id: 1, status: 1, _id: -1 })
+
+// 用量流水与按天汇总, 汇总表按统计维度唯一
+db.usage.createIndex({ user_id: 1, create_time: -1 })
+db.usage_daily.createIndex({ date: 1, user_id: 1, model: 1, bot_id: 1 }, { unique: true })
+
+// 管理端统计, 活跃与留存按用户消息的时间聚合, 消息量按模型消息的时间聚合
+db.message.createIndex({ role: 1, create_time: 1 })
+db.user.createIndex({ create_time: 1 })
+db.user.createIndex({ login_time: 1 })
@@ -0,0 +1,65 @@
+package errno
+
+import (
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx/code"
+)
+
+const (
+	APIKeyCreateErrCode        = 130001
+	APIKeyListErrCode          = 130002
+	APIKeyUpdateErrCode        = 130003
+	APIKeyRevokeErrCode        = 130004
+	APIKeyNotFoundErrCode      = 130005
+	APIKeyLimitErrCode         = 130006
+	APIKeyModelDeniedErrCode   = 130007
+	APIKeyQuotaExceededErrCode = 130008
+	APIKeyManageDeniedErrCode  = 130009
+)
+
+func init() {
+	code.Register(
+		APIKeyCreateErrCode,
+		"创建密钥失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		APIKeyListErrCode,
+		"获取密钥列表失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		APIKeyUpdateErrCode,
+		"更新密钥失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		APIKeyRevokeErrCode,
+		"撤销密钥失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		APIKeyNotFoundErrCode,
+		"密钥不存在或已被撤销",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		APIKeyLimitErrCode,
+		"密钥数量已达上限: {limit}",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		APIKeyModelDeniedErrCode,
+		"密钥无权调用模型: {model}",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		APIKeyQuotaExceededErrCode,
+		"密钥配额已用尽",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		APIKeyManageDeniedErrCode,
+		"不能使用密钥管理密钥, 请登录后操作",
+		code.WithAffectStability(false),
+	)
+}
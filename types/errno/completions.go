@@ -5,8 +5,9 @@ import (
 )
 
 const (
-	CompletionsErrCode = 70001
-	ErrSensitive       = 700_000_002
+	CompletionsErrCode     = 70001
+	CompletionsEditErrCode = 70002
+	ErrSensitive           = 700_000_002
 )
 
 func init() {
@@ -15,6 +16,11 @@ func init() {
 		"对话生成失败",
 		code.WithAffectStability(false),
 	)
+	code.Register(
+		CompletionsEditErrCode,
+		"编辑的消息不存在或不在当前分支上",
+		code.WithAffectStability(false),
+	)
 	code.Register(
 		ErrSensitive,
 		"输入 {text} 为违禁词, 请不要谈论敏感话题, 否则账号将遭到封禁",
@@ -5,14 +5,32 @@ import (
 )
 
 const (
-	ConversationCreateErrCode        = 30001
-	ConversationRenameErrCode        = 30002
-	ConversationListErrCode          = 30003
-	ConversationGetErrCode           = 30004
-	ConversationDeleteErrCode        = 30005
-	ConversationSearchErrCode        = 30006
-	ConversationGenerateBriefErrCode = 30007
-	ConversationExtUpdateErrCode     = 30008
+	ConversationCreateErrCode         = 30001
+	ConversationRenameErrCode         = 30002
+	ConversationListErrCode           = 30003
+	ConversationGetErrCode            = 30004
+	ConversationDeleteErrCode         = 30005
+	ConversationSearchErrCode         = 30006
+	ConversationGenerateBriefErrCode  = 30007
+	ConversationExtUpdateErrCode      = 30008
+	ConversationNewSectionErrCode     = 30009
+	ConversationBranchNotFoundErrCode = 30010
+	ConversationListBranchErrCode     = 30011
+	ConversationSwitchBranchErrCode   = 30012
+	ConversationForkErrCode           = 30013
+	ConversationPinErrCode            = 30014
+	ConversationMoveErrCode           = 30015
+	ConversationTagErrCode            = 30016
+	ConversationTagInvalidErrCode     = 30017
+	FolderCreateErrCode               = 30018
+	FolderListErrCode                 = 30019
+	FolderRenameErrCode               = 30020
+	FolderDeleteErrCode               = 30021
+	FolderNotFoundErrCode             = 30022
+	FolderNameInvalidErrCode          = 30023
+	ConversationListTrashErrCode      = 30024
+	ConversationRestoreErrCode        = 30025
+	ConversationNotInTrashErrCode     = 30026
 )
 
 func init() {
@@ -56,4 +74,94 @@ func init() {
 		"更新对话扩展信息失败",
 		code.WithAffectStability(false),
 	)
+	code.Register(
+		ConversationNewSectionErrCode,
+		"新建段落失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationBranchNotFoundErrCode,
+		"消息不存在",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationListBranchErrCode,
+		"获取消息分支失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationSwitchBranchErrCode,
+		"切换消息分支失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationForkErrCode,
+		"分叉对话失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationPinErrCode,
+		"置顶对话失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationMoveErrCode,
+		"移动对话失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationTagErrCode,
+		"设置标签失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationTagInvalidErrCode,
+		"标签不合法: {reason}",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		FolderCreateErrCode,
+		"创建文件夹失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		FolderListErrCode,
+		"获取文件夹失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		FolderRenameErrCode,
+		"重命名文件夹失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		FolderDeleteErrCode,
+		"删除文件夹失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		FolderNotFoundErrCode,
+		"文件夹不存在",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		FolderNameInvalidErrCode,
+		"文件夹名称不合法: {reason}",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationListTrashErrCode,
+		"获取回收站失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationRestoreErrCode,
+		"恢复对话失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ConversationNotInTrashErrCode,
+		"对话不在回收站中",
+		code.WithAffectStability(false),
+	)
 }
@@ -0,0 +1,41 @@
+package errno
+
+import (
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx/code"
+)
+
+const (
+	ExportErrCode        = 100001
+	ExportFormatErrCode  = 100002
+	ExportRunningErrCode = 100003
+	ImportErrCode        = 100004
+	ImportInvalidErrCode = 100005
+)
+
+func init() {
+	code.Register(
+		ExportErrCode,
+		"导出对话失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ExportFormatErrCode,
+		"不支持的导出格式",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ExportRunningErrCode,
+		"已有正在进行的导出任务, 请稍后再试",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ImportErrCode,
+		"导入对话失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ImportInvalidErrCode,
+		"导入内容格式错误: {reason}",
+		code.WithAffectStability(false),
+	)
+}
@@ -0,0 +1,29 @@
+package errno
+
+import (
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx/code"
+)
+
+const (
+	GatewayErrCode              = 120001
+	GatewayInvalidErrCode       = 120002
+	GatewayModelNotFoundErrCode = 120003
+)
+
+func init() {
+	code.Register(
+		GatewayErrCode,
+		"模型调用失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		GatewayInvalidErrCode,
+		"请求不合法: {reason}",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		GatewayModelNotFoundErrCode,
+		"模型不存在: {model}",
+		code.WithAffectStability(false),
+	)
+}
@@ -0,0 +1,29 @@
+package errno
+
+import (
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx/code"
+)
+
+const (
+	RateLimitedErrCode          = 140001
+	DailyQuotaExceededErrCode   = 140002
+	MonthlyQuotaExceededErrCode = 140003
+)
+
+func init() {
+	code.Register(
+		RateLimitedErrCode,
+		"请求过于频繁, 请{retry}秒后再试",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		DailyQuotaExceededErrCode,
+		"今日额度已用尽: {scope}",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		MonthlyQuotaExceededErrCode,
+		"本月额度已用尽: {scope}",
+		code.WithAffectStability(false),
+	)
+}
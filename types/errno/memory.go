@@ -0,0 +1,23 @@
+package errno
+
+import (
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx/code"
+)
+
+const (
+	MemoryListErrCode   = 90001
+	MemoryDeleteErrCode = 90002
+)
+
+func init() {
+	code.Register(
+		MemoryListErrCode,
+		"获取记忆失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		MemoryDeleteErrCode,
+		"删除记忆失败",
+		code.WithAffectStability(false),
+	)
+}
@@ -0,0 +1,59 @@
+package errno
+
+import (
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx/code"
+)
+
+const (
+	ShareCreateErrCode   = 110001
+	ShareNotFoundErrCode = 110002
+	ShareExpiredErrCode  = 110003
+	SharePasswordErrCode = 110004
+	ShareListErrCode     = 110005
+	ShareRevokeErrCode   = 110006
+	ShareContinueErrCode = 110007
+	ShareLockedErrCode   = 110008
+)
+
+func init() {
+	code.Register(
+		ShareCreateErrCode,
+		"创建分享失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ShareNotFoundErrCode,
+		"分享不存在或已被撤销",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ShareExpiredErrCode,
+		"分享已过期",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		SharePasswordErrCode,
+		"访问密码错误",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ShareListErrCode,
+		"获取分享列表失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ShareRevokeErrCode,
+		"撤销分享失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ShareContinueErrCode,
+		"继续对话失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		ShareLockedErrCode,
+		"访问密码错误次数过多, 请稍后再试",
+		code.WithAffectStability(false),
+	)
+}
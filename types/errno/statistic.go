@@ -0,0 +1,23 @@
+package errno
+
+import (
+	"github.com/xh-polaris/innospark-core-api/pkg/errorx/code"
+)
+
+const (
+	StatisticErrCode      = 150001
+	StatisticParamErrCode = 150002
+)
+
+func init() {
+	code.Register(
+		StatisticErrCode,
+		"统计失败",
+		code.WithAffectStability(false),
+	)
+	code.Register(
+		StatisticParamErrCode,
+		"统计参数错误: {reason}",
+		code.WithAffectStability(false),
+	)
+}